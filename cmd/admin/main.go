@@ -0,0 +1,10 @@
+package main
+
+import (
+	"file-server/internal/app/admin"
+	"os"
+)
+
+func main() {
+	os.Exit(admin.Run(os.Args[1:]))
+}
@@ -0,0 +1,7 @@
+ALTER TABLE upload_sessions
+DROP INDEX idx_upload_sessions_updated_at;
+
+ALTER TABLE upload_sessions
+DROP FOREIGN key fk_upload_sessions_folder_id;
+
+DROP TABLE IF EXISTS upload_sessions;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS upload_sessions (
+  id VARCHAR(32) NOT NULL COMMENT "ID",
+  folder_id BIGINT UNSIGNED NOT NULL COMMENT "フォルダID",
+  name VARCHAR(128) NOT NULL COMMENT "ファイル名",
+  size BIGINT UNSIGNED NOT NULL COMMENT "ファイルサイズ",
+  offset BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT "アップロード済みサイズ",
+  is_hide TINYINT (1) NOT NULL DEFAULT 0 COMMENT "非表示フラグ",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_upload_sessions_folder_id FOREIGN KEY (folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  INDEX idx_upload_sessions_updated_at (updated_at)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS blobs (
+  hash CHAR(64) NOT NULL COMMENT "SHA-256ハッシュ",
+  size BIGINT UNSIGNED NOT NULL COMMENT "サイズ",
+  reference_count BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT "参照数",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (hash)
+);
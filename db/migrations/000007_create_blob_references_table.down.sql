@@ -0,0 +1,4 @@
+ALTER TABLE blob_references
+DROP FOREIGN key fk_blob_references_hash;
+
+DROP TABLE IF EXISTS blob_references;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS blob_references (
+  path VARCHAR(255) NOT NULL COMMENT "ファイルパス",
+  hash CHAR(64) NOT NULL COMMENT "SHA-256ハッシュ",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (path),
+  CONSTRAINT fk_blob_references_hash FOREIGN KEY (hash) REFERENCES blobs (hash) ON UPDATE CASCADE
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS users (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  name VARCHAR(64) NOT NULL COMMENT "ユーザー名",
+  password TEXT NOT NULL COMMENT "パスワード",
+  is_admin TINYINT(1) NOT NULL DEFAULT 0 COMMENT "管理者フラグ",
+  is_disabled TINYINT(1) NOT NULL DEFAULT 0 COMMENT "無効フラグ",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id),
+  CONSTRAINT uq_users_name UNIQUE (name)
+);
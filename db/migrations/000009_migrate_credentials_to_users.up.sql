@@ -0,0 +1,2 @@
+INSERT INTO users (name, password, is_admin) SELECT "admin", password, 1 FROM credentials ORDER BY id LIMIT 1;
+DROP TABLE IF EXISTS credentials;
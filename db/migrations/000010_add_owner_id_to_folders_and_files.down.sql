@@ -0,0 +1,2 @@
+ALTER TABLE files DROP FOREIGN KEY fk_files_owner_id, DROP COLUMN owner_id;
+ALTER TABLE folders DROP FOREIGN KEY fk_folders_owner_id, DROP COLUMN owner_id;
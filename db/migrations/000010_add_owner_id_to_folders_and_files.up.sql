@@ -0,0 +1,6 @@
+ALTER TABLE folders
+  ADD COLUMN owner_id BIGINT UNSIGNED NULL COMMENT "所有者ID" AFTER parent_folder_id,
+  ADD CONSTRAINT fk_folders_owner_id FOREIGN KEY (owner_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE files
+  ADD COLUMN owner_id BIGINT UNSIGNED NULL COMMENT "所有者ID" AFTER folder_id,
+  ADD CONSTRAINT fk_files_owner_id FOREIGN KEY (owner_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS access_control_entries (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  folder_id BIGINT UNSIGNED NOT NULL COMMENT "フォルダID",
+  user_id BIGINT UNSIGNED NOT NULL COMMENT "ユーザーID",
+  permission VARCHAR(8) NOT NULL COMMENT "権限",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_access_control_entries_folder_id FOREIGN KEY (folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_access_control_entries_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT uq_access_control_entries_folder_id_user_id UNIQUE (folder_id, user_id)
+);
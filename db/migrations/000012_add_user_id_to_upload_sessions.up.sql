@@ -0,0 +1 @@
+ALTER TABLE upload_sessions ADD COLUMN user_id BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT "ユーザーID" AFTER id;
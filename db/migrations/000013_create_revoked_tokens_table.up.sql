@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+  id CHAR(32) NOT NULL COMMENT "トークンID",
+  user_id BIGINT UNSIGNED NOT NULL COMMENT "ユーザーID",
+  expires_at DATETIME (6) NOT NULL COMMENT "有効期限",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_revoked_tokens_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  INDEX idx_revoked_tokens_expires_at (expires_at)
+);
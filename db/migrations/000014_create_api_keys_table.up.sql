@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  user_id BIGINT UNSIGNED NOT NULL COMMENT "ユーザーID",
+  name VARCHAR(64) NOT NULL COMMENT "キー名",
+  prefix CHAR(16) NOT NULL COMMENT "キー識別子",
+  hash TEXT NOT NULL COMMENT "キーハッシュ",
+  scope VARCHAR(8) NOT NULL COMMENT "スコープ",
+  folder_id BIGINT UNSIGNED NULL COMMENT "フォルダID",
+  expires_at DATETIME (6) NOT NULL COMMENT "有効期限",
+  last_used_at DATETIME (6) NULL COMMENT "最終使用日",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_api_keys_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_api_keys_folder_id FOREIGN KEY (folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT uq_api_keys_prefix UNIQUE (prefix)
+);
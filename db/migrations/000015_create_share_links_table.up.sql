@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS share_links (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  token VARCHAR(64) NOT NULL COMMENT "共有トークン",
+  user_id BIGINT UNSIGNED NOT NULL COMMENT "ユーザーID",
+  file_id BIGINT UNSIGNED NULL COMMENT "ファイルID",
+  folder_id BIGINT UNSIGNED NULL COMMENT "フォルダID",
+  password TEXT NOT NULL COMMENT "パスワード",
+  expires_at DATETIME (6) NULL COMMENT "有効期限",
+  max_downloads BIGINT UNSIGNED NULL COMMENT "最大ダウンロード数",
+  download_count BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT "ダウンロード数",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_share_links_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_share_links_file_id FOREIGN KEY (file_id) REFERENCES files (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_share_links_folder_id FOREIGN KEY (folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT uq_share_links_token UNIQUE (token)
+);
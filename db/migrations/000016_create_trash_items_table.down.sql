@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS trash_items;
+ALTER TABLE files DROP COLUMN deleted_at;
+ALTER TABLE folders DROP COLUMN deleted_at;
@@ -0,0 +1,19 @@
+ALTER TABLE folders
+  ADD COLUMN deleted_at DATETIME (6) NULL COMMENT "削除日" AFTER updated_at;
+ALTER TABLE files
+  ADD COLUMN deleted_at DATETIME (6) NULL COMMENT "削除日" AFTER updated_at;
+CREATE TABLE IF NOT EXISTS trash_items (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  user_id BIGINT UNSIGNED NOT NULL COMMENT "削除したユーザーID",
+  folder_id BIGINT UNSIGNED NULL COMMENT "フォルダID",
+  file_id BIGINT UNSIGNED NULL COMMENT "ファイルID",
+  parent_folder_id BIGINT UNSIGNED NULL COMMENT "元の親フォルダID",
+  name VARCHAR(128) NOT NULL COMMENT "元の名前",
+  path VARCHAR(255) NOT NULL COMMENT "元のパス",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "削除日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_trash_items_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_trash_items_folder_id FOREIGN KEY (folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_trash_items_file_id FOREIGN KEY (file_id) REFERENCES files (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  CONSTRAINT fk_trash_items_parent_folder_id FOREIGN KEY (parent_folder_id) REFERENCES folders (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS file_versions (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  file_id BIGINT UNSIGNED NOT NULL COMMENT "ファイルID",
+  mime_type VARCHAR(64) NOT NULL COMMENT "MIMEタイプ",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  PRIMARY KEY (id),
+  CONSTRAINT fk_file_versions_file_id FOREIGN KEY (file_id) REFERENCES files (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
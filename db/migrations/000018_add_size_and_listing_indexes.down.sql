@@ -0,0 +1,12 @@
+ALTER TABLE folders
+  DROP INDEX idx_folders_parent_folder_id_name,
+  DROP INDEX idx_folders_parent_folder_id_created_at,
+  DROP INDEX idx_folders_parent_folder_id_updated_at;
+ALTER TABLE file_versions DROP COLUMN size;
+ALTER TABLE files
+  DROP INDEX idx_files_folder_id_name,
+  DROP INDEX idx_files_folder_id_size,
+  DROP INDEX idx_files_folder_id_mime_type,
+  DROP INDEX idx_files_folder_id_created_at,
+  DROP INDEX idx_files_folder_id_updated_at,
+  DROP COLUMN size;
@@ -0,0 +1,13 @@
+ALTER TABLE files
+  ADD COLUMN size BIGINT NOT NULL DEFAULT 0 COMMENT "ファイルサイズ" AFTER mime_type,
+  ADD INDEX idx_files_folder_id_name (folder_id, name, id),
+  ADD INDEX idx_files_folder_id_size (folder_id, size, id),
+  ADD INDEX idx_files_folder_id_mime_type (folder_id, mime_type, id),
+  ADD INDEX idx_files_folder_id_created_at (folder_id, created_at, id),
+  ADD INDEX idx_files_folder_id_updated_at (folder_id, updated_at, id);
+ALTER TABLE file_versions
+  ADD COLUMN size BIGINT NOT NULL DEFAULT 0 COMMENT "ファイルサイズ" AFTER mime_type;
+ALTER TABLE folders
+  ADD INDEX idx_folders_parent_folder_id_name (parent_folder_id, name, id),
+  ADD INDEX idx_folders_parent_folder_id_created_at (parent_folder_id, created_at, id),
+  ADD INDEX idx_folders_parent_folder_id_updated_at (parent_folder_id, updated_at, id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS journals (
+  id BIGINT UNSIGNED AUTO_INCREMENT COMMENT "ID",
+  status VARCHAR(16) NOT NULL COMMENT "状態",
+  operations LONGTEXT NOT NULL COMMENT "ストレージ操作",
+  created_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "作成日",
+  updated_at DATETIME (6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT "更新日",
+  PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE users
+  ADD COLUMN tokens_valid_after DATETIME (6) COMMENT "トークン有効開始日時" AFTER is_disabled;
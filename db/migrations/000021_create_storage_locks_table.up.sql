@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS storage_locks (
+  id TINYINT UNSIGNED COMMENT "ID",
+  PRIMARY KEY (id)
+);
+INSERT INTO
+  storage_locks (id)
+VALUES
+  (1);
@@ -0,0 +1,5 @@
+ALTER TABLE trash_items
+  DROP FOREIGN KEY fk_trash_items_user_id;
+ALTER TABLE trash_items
+  MODIFY COLUMN user_id BIGINT UNSIGNED NULL COMMENT "削除したユーザーID",
+  ADD CONSTRAINT fk_trash_items_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL;
@@ -0,0 +1,121 @@
+package admin
+
+import (
+	"bufio"
+	"errors"
+	"file-server/internal/pkg/config"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	ExitOK           = 0
+	ExitError        = 1
+	ExitUsage        = 2
+	ExitNotFound     = 3
+	ExitInconsistent = 4
+)
+
+const usage = `usage: admin <command> [arguments]
+
+commands:
+  password <name>                  set or rotate the password of a user (read from stdin)
+  create-user [-admin] <name>      create a user (password read from stdin)
+  tokens list <name>               list the api keys of a user
+  tokens revoke <name> <id>        revoke an api key of a user
+  migrate [-path dir] up [n]       apply all or n pending migrations
+  migrate [-path dir] down [n]     roll back all or n applied migrations
+  migrate [-path dir] version      print the current migration version
+  migrate [-path dir] force <v>    set the migration version without running it
+  check [-repair [-dry-run]]       report inconsistencies between database and storage as json
+  import [-folder path] <dir>      copy new and changed files of a host directory into a folder
+  import -adopt <dir>              register new and changed files inside the local storage in place
+
+exit codes:
+  0  success
+  1  error
+  2  invalid usage
+  3  user or token not found
+  4  inconsistency found or left unrepaired
+`
+
+func Run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return ExitUsage
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(os.Stdout, usage)
+		return ExitOK
+	}
+
+	if err := config.Load(); err != nil {
+		return fail(err)
+	}
+
+	switch args[0] {
+	case "password":
+		return runPassword(args[1:])
+	case "create-user":
+		return runCreateUser(args[1:])
+	case "tokens":
+		return runTokens(args[1:])
+	case "migrate":
+		return runMigrate(args[1:])
+	case "check":
+		return runCheck(args[1:])
+	case "import":
+		return runImport(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n%s", args[0], usage)
+		return ExitUsage
+	}
+}
+
+func open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+}
+
+var connect = func() (*gorm.DB, error) {
+	db, err := open(config.MYSQL_DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := inject(db); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func readPassword(r io.Reader) (string, error) {
+	if f, ok := r.(*os.File); ok {
+		if info, err := f.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+			fmt.Fprint(os.Stderr, "password: ")
+		}
+	}
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func invalid(format string, a ...any) int {
+	fmt.Fprintf(os.Stderr, format+"\n\n%s", append(a, usage)...)
+	return ExitUsage
+}
+
+func fail(err error) int {
+	fmt.Fprintln(os.Stderr, err.Error())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ExitNotFound
+	}
+	return ExitError
+}
@@ -0,0 +1,299 @@
+package admin
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func setup(t *testing.T) {
+	t.Setenv("API_PORT", "8080")
+	t.Setenv("MYSQL_PORT", "3306")
+	t.Setenv("STORAGE_DRIVER", "local")
+
+	original := connect
+	t.Cleanup(func() { connect = original })
+	connect = func() (*gorm.DB, error) {
+		return nil, nil
+	}
+
+	stdout, stderr := os.Stdout, os.Stderr
+	t.Cleanup(func() { os.Stdout, os.Stderr = stdout, stderr })
+	devnull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(func() { devnull.Close() })
+	os.Stdout, os.Stderr = devnull, devnull
+}
+
+func setStdin(t *testing.T, input string) {
+	path := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(path, []byte(input), 0644); err != nil {
+		t.Fatal(err.Error())
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	stdin := os.Stdin
+	t.Cleanup(func() {
+		os.Stdin = stdin
+		f.Close()
+	})
+	os.Stdin = f
+}
+
+func TestRunUsage(t *testing.T) {
+	setup(t)
+
+	for _, v := range []struct {
+		args []string
+		code int
+	}{
+		{[]string{}, ExitUsage},
+		{[]string{"help"}, ExitOK},
+		{[]string{"--help"}, ExitOK},
+		{[]string{"unknown"}, ExitUsage},
+		{[]string{"password"}, ExitUsage},
+		{[]string{"password", "a", "b"}, ExitUsage},
+		{[]string{"create-user"}, ExitUsage},
+		{[]string{"create-user", "-unknown", "name"}, ExitUsage},
+		{[]string{"tokens"}, ExitUsage},
+		{[]string{"tokens", "list"}, ExitUsage},
+		{[]string{"tokens", "revoke", "name"}, ExitUsage},
+		{[]string{"tokens", "revoke", "name", "abc"}, ExitUsage},
+		{[]string{"tokens", "unknown"}, ExitUsage},
+		{[]string{"migrate"}, ExitUsage},
+		{[]string{"migrate", "up", "0"}, ExitUsage},
+		{[]string{"migrate", "up", "1", "2"}, ExitUsage},
+		{[]string{"migrate", "force"}, ExitUsage},
+		{[]string{"migrate", "version", "1"}, ExitUsage},
+		{[]string{"migrate", "unknown"}, ExitUsage},
+		{[]string{"check", "-dry-run"}, ExitUsage},
+		{[]string{"check", "extra"}, ExitUsage},
+		{[]string{"import"}, ExitUsage},
+		{[]string{"import", "-adopt", "-folder", "/a/", t.TempDir()}, ExitUsage},
+		{[]string{"import", "-folder", "a", t.TempDir()}, ExitUsage},
+	} {
+		if code := Run(v.args); code != v.code {
+			t.Errorf("%v: expected exit code %d, got %d", v.args, v.code, code)
+		}
+	}
+}
+
+func TestRunWithInvalidConfig(t *testing.T) {
+	setup(t)
+	t.Setenv("API_PORT", "")
+
+	if code := Run([]string{"check"}); code != ExitError {
+		t.Errorf("expected exit code %d, got %d", ExitError, code)
+	}
+}
+
+func TestRunWithConnectionError(t *testing.T) {
+	setup(t)
+	connect = func() (*gorm.DB, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	if code := Run([]string{"check"}); code != ExitError {
+		t.Errorf("expected exit code %d, got %d", ExitError, code)
+	}
+}
+
+func TestRunPassword(t *testing.T) {
+	setup(t)
+	setStdin(t, "new-password\n")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindOneByName("name").Return(&dto.UserDTO{ID: 1, Name: "name"}, nil)
+	uu.EXPECT().SetPassword(uint64(1), "new-password").Return(&dto.UserDTO{ID: 1, Name: "name"}, nil)
+	userUsecase = uu
+
+	if code := Run([]string{"password", "name"}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+}
+
+func TestRunPasswordWithUnknownUser(t *testing.T) {
+	setup(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindOneByName("name").Return(nil, gorm.ErrRecordNotFound)
+	userUsecase = uu
+
+	if code := Run([]string{"password", "name"}); code != ExitNotFound {
+		t.Errorf("expected exit code %d, got %d", ExitNotFound, code)
+	}
+}
+
+func TestRunPasswordTooShort(t *testing.T) {
+	setup(t)
+	setStdin(t, "short\n")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindOneByName("name").Return(&dto.UserDTO{ID: 1, Name: "name"}, nil)
+	uu.EXPECT().SetPassword(uint64(1), "short").Return(nil, entity.NewValidationError("password_too_short", "password is too short"))
+	userUsecase = uu
+
+	if code := Run([]string{"password", "name"}); code != ExitError {
+		t.Errorf("expected exit code %d, got %d", ExitError, code)
+	}
+}
+
+func TestRunCreateUser(t *testing.T) {
+	setup(t)
+	setStdin(t, "password")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().Create("name", "password", true).Return(&dto.UserDTO{ID: 1, Name: "name", IsAdmin: true}, nil)
+	userUsecase = uu
+
+	if code := Run([]string{"create-user", "-admin", "name"}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+}
+
+func TestRunTokensList(t *testing.T) {
+	setup(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindOneByName("name").Return(&dto.UserDTO{ID: 1, Name: "name"}, nil)
+	userUsecase = uu
+
+	au := mock_usecase.NewMockAPIKeyUsecase(ctrl)
+	au.EXPECT().FindAll(entity.Principal{UserID: 1}).Return([]dto.APIKeyDTO{{ID: 2, Name: "key", Scope: "read"}}, nil)
+	apiKeyUsecase = au
+
+	if code := Run([]string{"tokens", "list", "name"}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+}
+
+func TestRunTokensRevoke(t *testing.T) {
+	setup(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindOneByName("name").Return(&dto.UserDTO{ID: 1, Name: "name"}, nil).Times(2)
+	userUsecase = uu
+
+	au := mock_usecase.NewMockAPIKeyUsecase(ctrl)
+	au.EXPECT().Remove(uint64(2), entity.Principal{UserID: 1}).Return(nil)
+	au.EXPECT().Remove(uint64(3), entity.Principal{UserID: 1}).Return(gorm.ErrRecordNotFound)
+	apiKeyUsecase = au
+
+	if code := Run([]string{"tokens", "revoke", "name", "2"}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+	if code := Run([]string{"tokens", "revoke", "name", "3"}); code != ExitNotFound {
+		t.Errorf("expected exit code %d, got %d", ExitNotFound, code)
+	}
+}
+
+func TestRunCheck(t *testing.T) {
+	setup(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cu := mock_usecase.NewMockCheckUsecase(ctrl)
+	cu.EXPECT().Check().Return([]dto.InconsistencyDTO{}, nil)
+	cu.EXPECT().Check().Return([]dto.InconsistencyDTO{*dto.NewInconsistencyDTO("file", 1, "/a", "", "missing_body", "", false)}, nil)
+	cu.EXPECT().Check().Return(nil, errors.New("storage error"))
+	checkUsecase = cu
+
+	for _, code := range []int{ExitOK, ExitInconsistent, ExitError} {
+		if v := Run([]string{"check"}); v != code {
+			t.Errorf("expected exit code %d, got %d", code, v)
+		}
+	}
+}
+
+func TestRunCheckWithRepair(t *testing.T) {
+	setup(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cu := mock_usecase.NewMockCheckUsecase(ctrl)
+	cu.EXPECT().Repair(false).Return([]dto.InconsistencyDTO{*dto.NewInconsistencyDTO("file", 1, "/a", "", "missing_body", "remove", true)}, nil)
+	cu.EXPECT().Repair(true).Return([]dto.InconsistencyDTO{*dto.NewInconsistencyDTO("file", 1, "/a", "", "missing_body", "remove", false)}, nil)
+	checkUsecase = cu
+
+	if code := Run([]string{"check", "-repair"}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+	if code := Run([]string{"check", "-repair", "-dry-run"}); code != ExitInconsistent {
+		t.Errorf("expected exit code %d, got %d", ExitInconsistent, code)
+	}
+}
+
+func TestRunImport(t *testing.T) {
+	setup(t)
+	dir := t.TempDir()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	iu := mock_usecase.NewMockImportUsecase(ctrl)
+	iu.EXPECT().Import(gomock.Any(), "/folder/", false).Return(dto.NewImportDTO(1, 2, 0, 0, nil), nil)
+	iu.EXPECT().Import(gomock.Any(), "/", false).Return(dto.NewImportDTO(0, 1, 0, 0, []dto.ImportFailureDTO{*dto.NewImportFailureDTO("/a", "permission denied")}), nil)
+	importUsecase = iu
+
+	if code := Run([]string{"import", "-folder", "/folder/", dir}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+	if code := Run([]string{"import", dir}); code != ExitError {
+		t.Errorf("expected exit code %d, got %d", ExitError, code)
+	}
+}
+
+func TestRunImportWithAdopt(t *testing.T) {
+	setup(t)
+	root := t.TempDir()
+	t.Setenv("STORAGE_PATH", root)
+	if err := os.Mkdir(filepath.Join(root, "folder"), 0755); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	iu := mock_usecase.NewMockImportUsecase(ctrl)
+	iu.EXPECT().Import(gomock.Any(), "/folder/", true).Return(dto.NewImportDTO(0, 1, 0, 0, nil), nil)
+	importUsecase = iu
+
+	if code := Run([]string{"import", "-adopt", filepath.Join(root, "folder")}); code != ExitOK {
+		t.Errorf("expected exit code %d, got %d", ExitOK, code)
+	}
+	if code := Run([]string{"import", "-adopt", t.TempDir()}); code != ExitUsage {
+		t.Errorf("expected exit code %d, got %d", ExitUsage, code)
+	}
+}
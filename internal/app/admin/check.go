@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"encoding/json"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase/dto"
+	"flag"
+	"io"
+	"os"
+)
+
+func runCheck(args []string) int {
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	repair := flags.Bool("repair", false, "")
+	dryRun := flags.Bool("dry-run", false, "")
+	if err := flags.Parse(args); err != nil {
+		return invalid("check: %s", err.Error())
+	}
+	if flags.NArg() != 0 {
+		return invalid("check: too many arguments")
+	}
+	if *dryRun && !*repair {
+		return invalid("check: -dry-run requires -repair")
+	}
+
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	var inconsistencies []dto.InconsistencyDTO
+	var err error
+	if *repair {
+		inconsistencies, err = checkUsecase.Repair(*dryRun)
+	} else {
+		inconsistencies, err = checkUsecase.Check()
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	res := make([]responses.InconsistencyResponse, len(inconsistencies))
+	remaining := 0
+	for i, v := range inconsistencies {
+		res[i] = *responses.NewInconsistencyResponse(v.Kind, v.ID, v.Path, v.ExpectedPath, v.Reason, v.Action, v.Repaired)
+		if !v.Repaired {
+			remaining++
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(res); err != nil {
+		return fail(err)
+	}
+	if remaining != 0 {
+		return ExitInconsistent
+	}
+	return ExitOK
+}
@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"file-server/internal/pkg/config"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func runImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	adopt := flags.Bool("adopt", false, "")
+	folder := flags.String("folder", "", "")
+	if err := flags.Parse(args); err != nil {
+		return invalid("import: %s", err.Error())
+	}
+	if flags.NArg() != 1 {
+		return invalid("import: directory is required")
+	}
+
+	dir, err := filepath.Abs(flags.Arg(0))
+	if err != nil {
+		return fail(err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fail(err)
+	}
+	if !info.IsDir() {
+		return invalid("import: not a directory: %s", flags.Arg(0))
+	}
+
+	path := *folder
+	if *adopt {
+		if path != "" {
+			return invalid("import: -folder cannot be used with -adopt")
+		}
+		if config.STORAGE_DRIVER != "local" {
+			return invalid("import: -adopt requires the local storage driver")
+		}
+		root, err := filepath.Abs(config.STORAGE_PATH)
+		if err != nil {
+			return fail(err)
+		}
+		rel, err := filepath.Rel(root, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return invalid("import: -adopt requires a directory inside %s", config.STORAGE_PATH)
+		}
+		path = "/"
+		if rel != "." {
+			path += filepath.ToSlash(rel) + "/"
+		}
+	}
+	if path == "" {
+		path = "/"
+	}
+	if !strings.HasPrefix(path, "/") || !strings.HasSuffix(path, "/") {
+		return invalid("import: invalid folder path: %s", path)
+	}
+
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	result, err := importUsecase.Import(os.DirFS(dir), path, *adopt)
+	if err != nil {
+		return fail(err)
+	}
+
+	for _, v := range result.Failures {
+		fmt.Fprintf(os.Stderr, "skipped %s: %s\n", v.Path, v.Reason)
+	}
+	fmt.Fprintf(os.Stdout, "folders created\t%d\nfiles created\t%d\nfiles updated\t%d\nfiles unchanged\t%d\n", result.CreatedFolders, result.CreatedFiles, result.UpdatedFiles, result.UnchangedFiles)
+	if len(result.Failures) != 0 {
+		return ExitError
+	}
+	return ExitOK
+}
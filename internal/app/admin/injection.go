@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/infrastructure"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/pkg/config"
+
+	"gorm.io/gorm"
+)
+
+var (
+	userRepository          repository.UserRepository
+	apiKeyRepository        repository.APIKeyRepository
+	accessControlRepository repository.AccessControlRepository
+	folderInfoRepository    repository.FolderInfoRepository
+	fileInfoRepository      repository.FileInfoRepository
+	fileVersionRepository   repository.FileVersionRepository
+	folderBodyRepository    repository.FolderBodyRepository
+	fileBodyRepository      repository.FileBodyRepository
+	journalRepository       repository.JournalRepository
+
+	userService          service.UserService
+	accessControlService service.AccessControlService
+	fileVersionService   service.FileVersionService
+	unitOfWork           service.UnitOfWork
+
+	userUsecase   usecase.UserUsecase
+	apiKeyUsecase usecase.APIKeyUsecase
+	checkUsecase  usecase.CheckUsecase
+	importUsecase usecase.ImportUsecase
+)
+
+func inject(db *gorm.DB) error {
+	policy, err := entity.NewAuthPolicy(config.AUTH_POLICY)
+	if err != nil {
+		return err
+	}
+
+	userRepository = infrastructure.NewUserInfrastructure()
+	apiKeyRepository = infrastructure.NewAPIKeyInfrastructure()
+	accessControlRepository = infrastructure.NewAccessControlInfrastructure()
+	folderInfoRepository = infrastructure.NewFolderInfoInfrastructure()
+	fileInfoRepository = infrastructure.NewFileInfoInfrastructure()
+	fileVersionRepository = infrastructure.NewFileVersionInfrastructure()
+	folderBodyRepository, fileBodyRepository, err = infrastructure.NewBodyInfrastructure(db)
+	if err != nil {
+		return err
+	}
+	journalRepository = infrastructure.NewJournalInfrastructure()
+	unitOfWork = service.NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+	folderBodyRepository = service.NewJournalFolderBodyRepository(journalRepository, folderBodyRepository)
+	fileBodyRepository = service.NewJournalFileBodyRepository(journalRepository, fileBodyRepository)
+
+	userService = service.NewUserService(userRepository)
+	accessControlService = service.NewAccessControlService(accessControlRepository, folderInfoRepository, userRepository, policy)
+	fileVersionService = service.NewFileVersionService(fileVersionRepository, folderBodyRepository, fileBodyRepository, config.FILE_VERSION_LIMIT)
+
+	userUsecase = usecase.NewUserUsecase(db, userRepository, userService)
+	apiKeyUsecase = usecase.NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+	checkUsecase = usecase.NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
+	importUsecase = usecase.NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	return nil
+}
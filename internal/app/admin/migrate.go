@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"errors"
+	"file-server/internal/pkg/config"
+	"file-server/internal/pkg/migration"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+func runMigrate(args []string) int {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	path := flags.String("path", "db/migrations", "")
+	if err := flags.Parse(args); err != nil {
+		return invalid("migrate: %s", err.Error())
+	}
+	if flags.NArg() == 0 {
+		return invalid("migrate: subcommand is required")
+	}
+
+	var n uint64
+	switch flags.Arg(0) {
+	case "up", "down":
+		if 2 < flags.NArg() {
+			return invalid("migrate %s: too many arguments", flags.Arg(0))
+		}
+		if flags.NArg() == 2 {
+			v, err := strconv.ParseUint(flags.Arg(1), 10, 64)
+			if err != nil || v == 0 {
+				return invalid("migrate %s: invalid number: %s", flags.Arg(0), flags.Arg(1))
+			}
+			n = v
+		}
+	case "force":
+		if flags.NArg() != 2 {
+			return invalid("migrate force: version is required")
+		}
+		v, err := strconv.ParseUint(flags.Arg(1), 10, 64)
+		if err != nil {
+			return invalid("migrate force: invalid version: %s", flags.Arg(1))
+		}
+		n = v
+	case "version":
+		if flags.NArg() != 1 {
+			return invalid("migrate version: too many arguments")
+		}
+	default:
+		return invalid("migrate: unknown subcommand: %s", flags.Arg(0))
+	}
+
+	migrations, err := migration.Load(*path)
+	if err != nil {
+		return fail(err)
+	}
+
+	db, err := open(config.MYSQL_DSN + "&multiStatements=true")
+	if err != nil {
+		return fail(err)
+	}
+	migrator := migration.NewMigrator(db, migrations)
+
+	switch flags.Arg(0) {
+	case "up":
+		err = migrator.Up(int(n))
+	case "down":
+		err = migrator.Down(int(n))
+	case "force":
+		err = migrator.Force(n)
+	case "version":
+		version, dirty, err := migrator.Version()
+		if err != nil {
+			return fail(err)
+		}
+		if dirty {
+			fmt.Fprintf(os.Stdout, "%d (dirty)\n", version)
+		} else {
+			fmt.Fprintln(os.Stdout, version)
+		}
+		return ExitOK
+	}
+	if errors.Is(err, migration.ErrNoChange) {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return ExitOK
+	}
+	if err != nil {
+		return fail(err)
+	}
+	return ExitOK
+}
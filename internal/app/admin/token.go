@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+func runTokens(args []string) int {
+	if len(args) == 0 {
+		return invalid("tokens: subcommand is required")
+	}
+
+	switch args[0] {
+	case "list":
+		if len(args) != 2 {
+			return invalid("tokens list: user name is required")
+		}
+		return listTokens(args[1])
+	case "revoke":
+		if len(args) != 3 {
+			return invalid("tokens revoke: user name and id are required")
+		}
+		id, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			return invalid("tokens revoke: invalid id: %s", args[2])
+		}
+		return revokeToken(args[1], id)
+	default:
+		return invalid("tokens: unknown subcommand: %s", args[0])
+	}
+}
+
+func listTokens(name string) int {
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	user, err := userUsecase.FindOneByName(name)
+	if err != nil {
+		return fail(err)
+	}
+
+	apiKeys, err := apiKeyUsecase.FindAll(entity.Principal{UserID: user.ID})
+	if err != nil {
+		return fail(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSCOPE\tFOLDER\tEXPIRES AT\tLAST USED AT")
+	for _, v := range apiKeys {
+		folderID := "-"
+		if v.FolderID != nil {
+			folderID = strconv.FormatUint(*v.FolderID, 10)
+		}
+		lastUsedAt := "-"
+		if v.LastUsedAt != nil {
+			lastUsedAt = v.LastUsedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", v.ID, v.Name, v.Scope, folderID, v.ExpiresAt.Format(time.RFC3339), lastUsedAt)
+	}
+	if err := w.Flush(); err != nil {
+		return fail(err)
+	}
+	return ExitOK
+}
+
+func revokeToken(name string, id uint64) int {
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	user, err := userUsecase.FindOneByName(name)
+	if err != nil {
+		return fail(err)
+	}
+
+	if err := apiKeyUsecase.Remove(id, entity.Principal{UserID: user.ID}); err != nil {
+		return fail(err)
+	}
+	return ExitOK
+}
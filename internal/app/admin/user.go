@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func runPassword(args []string) int {
+	if len(args) != 1 {
+		return invalid("password: user name is required")
+	}
+
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	user, err := userUsecase.FindOneByName(args[0])
+	if err != nil {
+		return fail(err)
+	}
+
+	password, err := readPassword(os.Stdin)
+	if err != nil {
+		return fail(err)
+	}
+
+	if _, err := userUsecase.SetPassword(user.ID, password); err != nil {
+		return fail(err)
+	}
+	return ExitOK
+}
+
+func runCreateUser(args []string) int {
+	flags := flag.NewFlagSet("create-user", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	isAdmin := flags.Bool("admin", false, "")
+	if err := flags.Parse(args); err != nil {
+		return invalid("create-user: %s", err.Error())
+	}
+	if flags.NArg() != 1 {
+		return invalid("create-user: user name is required")
+	}
+
+	if _, err := connect(); err != nil {
+		return fail(err)
+	}
+
+	password, err := readPassword(os.Stdin)
+	if err != nil {
+		return fail(err)
+	}
+
+	user, err := userUsecase.Create(flags.Arg(0), password, *isAdmin)
+	if err != nil {
+		return fail(err)
+	}
+
+	fmt.Fprintf(os.Stdout, "%d\t%s\n", user.ID, user.Name)
+	return ExitOK
+}
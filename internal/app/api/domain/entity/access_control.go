@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+var ErrPermissionDenied = NewError(ErrForbidden, "permission_denied", "permission denied")
+
+type Permission int
+
+const (
+	PermissionNone Permission = iota
+	PermissionRead
+	PermissionWrite
+	PermissionShare
+	PermissionAdmin
+)
+
+var permissionNames = map[Permission]string{
+	PermissionRead:  "read",
+	PermissionWrite: "write",
+	PermissionShare: "share",
+	PermissionAdmin: "admin",
+}
+
+func NewPermission(name string) (Permission, error) {
+	for k, v := range permissionNames {
+		if v == name {
+			return k, nil
+		}
+	}
+	return PermissionNone, NewValidationError("invalid_permission", "invalid permission")
+}
+
+func (p Permission) String() string {
+	return permissionNames[p]
+}
+
+func (p Permission) Allows(required Permission) bool {
+	return required <= p
+}
+
+type AccessControlEntry struct {
+	ID         uint64
+	FolderID   uint64
+	UserID     uint64
+	Permission Permission
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewAccessControlEntry(folderID uint64, userID uint64, permission string) (*AccessControlEntry, error) {
+	entry := &AccessControlEntry{
+		FolderID: folderID,
+		UserID:   userID,
+	}
+	if err := entry.SetPermission(permission); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (a *AccessControlEntry) SetPermission(permission string) error {
+	p, err := NewPermission(permission)
+	if err != nil {
+		return err
+	}
+	a.Permission = p
+	return nil
+}
+
+type AccessControlList struct {
+	UserID  uint64
+	IsAdmin bool
+	Default Permission
+	Entries []AccessControlEntry
+	Scope   Permission
+	Root    string
+}
+
+func NewAccessControlList(policy AuthPolicy, user *User, entries []AccessControlEntry) *AccessControlList {
+	if user == nil {
+		return &AccessControlList{
+			Default: policy.DefaultPermission(0),
+			Scope:   PermissionAdmin,
+		}
+	}
+	return &AccessControlList{
+		UserID:  user.ID,
+		IsAdmin: user.IsAdmin,
+		Default: policy.DefaultPermission(user.ID),
+		Entries: entries,
+		Scope:   PermissionAdmin,
+	}
+}
+
+func (a *AccessControlList) Restrict(scope Permission, root string) {
+	a.Scope = scope
+	a.Root = root
+}
+
+func (a *AccessControlList) Grant(folder *FolderInfo, inherited Permission) Permission {
+	if a.IsAdmin || a.isOwner(folder.OwnerID) {
+		return PermissionAdmin
+	}
+	p := inherited
+	for _, v := range a.Entries {
+		if v.FolderID == folder.ID && p < v.Permission {
+			p = v.Permission
+		}
+	}
+	return p
+}
+
+func (a *AccessControlList) FolderPermission(folder *FolderInfo, inherited Permission) Permission {
+	return a.restrict(a.visible(a.Grant(folder, inherited), folder.IsHide), folder.Path.Value)
+}
+
+func (a *AccessControlList) FilePermission(file *FileInfo, inherited Permission) Permission {
+	if a.IsAdmin || a.isOwner(file.OwnerID) {
+		return a.restrict(PermissionAdmin, file.Path.Value)
+	}
+	return a.restrict(a.visible(inherited, file.IsHide), file.Path.Value)
+}
+
+func (a *AccessControlList) Filter(folder *FolderInfo, inherited Permission) {
+	grant := a.Grant(folder, inherited)
+
+	folders := make([]FolderInfo, 0, len(folder.Folders))
+	for _, v := range folder.Folders {
+		if a.FolderPermission(&v, grant).Allows(PermissionRead) {
+			a.Filter(&v, grant)
+			folders = append(folders, v)
+		}
+	}
+	files := make([]FileInfo, 0, len(folder.Files))
+	for _, v := range folder.Files {
+		if a.FilePermission(&v, grant).Allows(PermissionRead) {
+			files = append(files, v)
+		}
+	}
+
+	if folder.Folders != nil {
+		folder.Folders = folders
+	}
+	if folder.Files != nil {
+		folder.Files = files
+	}
+}
+
+func (a *AccessControlList) Visibility(folder *FolderInfo, inherited Permission) Visibility {
+	if a.Grant(folder, inherited).Allows(PermissionRead) {
+		return Visibility{IncludeHidden: true}
+	}
+
+	var visibility Visibility
+	if a.UserID != 0 {
+		visibility.OwnerID = &a.UserID
+	}
+	for _, v := range a.Entries {
+		if v.Permission.Allows(PermissionRead) {
+			visibility.FolderIDs = append(visibility.FolderIDs, v.FolderID)
+		}
+	}
+	return visibility
+}
+
+func (a *AccessControlList) Traverse(root *FolderInfo, inherited Permission, path string, ancestors map[string]FolderInfo) (Permission, bool) {
+	grant := a.Grant(root, inherited)
+	for _, v := range AncestorPaths(path) {
+		if len(v) <= len(root.Path.Value) {
+			continue
+		}
+		folder, ok := ancestors[v]
+		if !ok || !a.FolderPermission(&folder, grant).Allows(PermissionRead) {
+			return PermissionNone, false
+		}
+		grant = a.Grant(&folder, grant)
+	}
+	return grant, true
+}
+
+func (a *AccessControlList) isOwner(ownerID *uint64) bool {
+	return a.UserID != 0 && ownerID != nil && *ownerID == a.UserID
+}
+
+func (a *AccessControlList) visible(p Permission, isHide bool) Permission {
+	if !isHide && p < a.Default {
+		return a.Default
+	}
+	return p
+}
+
+func (a *AccessControlList) restrict(p Permission, path string) Permission {
+	if a.Root != "" && !strings.HasPrefix(path, a.Root) {
+		return PermissionNone
+	}
+	if a.Scope < p {
+		return a.Scope
+	}
+	return p
+}
+
+func AncestorPaths(path string) []string {
+	var paths []string
+	for i := 0; i < len(path)-1; i++ {
+		if path[i] == '/' {
+			paths = append(paths, path[:i+1])
+		}
+	}
+	return paths
+}
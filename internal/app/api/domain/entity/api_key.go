@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrAPIKeyExpired = NewError(ErrUnauthorized, "api_key_expired", "api key is expired")
+
+const apiKeyPrefix = "fsk_"
+
+type APIKey struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Prefix     string
+	Hash       string
+	Scope      Permission
+	FolderID   *uint64
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewAPIKey(userID uint64, name string, scope string, folderID *uint64, expiresAt time.Time) (*APIKey, string, error) {
+	apiKey := &APIKey{
+		UserID:    userID,
+		FolderID:  folderID,
+		ExpiresAt: expiresAt,
+	}
+	if err := apiKey.SetName(name); err != nil {
+		return nil, "", err
+	}
+	if err := apiKey.SetScope(scope); err != nil {
+		return nil, "", err
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, "", NewValidationError("invalid_expiration", "invalid expiration")
+	}
+
+	prefix := make([]byte, 8)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, "", err
+	}
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	apiKey.Prefix = hex.EncodeToString(prefix)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, "", err
+	}
+	apiKey.Hash = string(hash)
+
+	return apiKey, apiKeyPrefix + apiKey.Prefix + "_" + hex.EncodeToString(secret), nil
+}
+
+func (a *APIKey) SetName(name string) error {
+	if name == "" {
+		return NewValidationError("invalid_api_key_name", "invalid api key name")
+	}
+	if 64 < len(name) {
+		return NewValidationError("api_key_name_too_long", "api key name is too long")
+	}
+	a.Name = name
+	return nil
+}
+
+func (a *APIKey) SetScope(scope string) error {
+	p, err := NewPermission(scope)
+	if err != nil {
+		return err
+	}
+	if p != PermissionRead && p != PermissionWrite {
+		return NewValidationError("invalid_api_key_scope", "invalid api key scope")
+	}
+	a.Scope = p
+	return nil
+}
+
+func (a *APIKey) Authenticate(secret string, now time.Time) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(a.Hash), []byte(secret)); err != nil {
+		return ErrInvalidCredential
+	}
+	if !now.Before(a.ExpiresAt) {
+		return ErrAPIKeyExpired
+	}
+	return nil
+}
+
+func (a *APIKey) Touch(now time.Time) bool {
+	if a.LastUsedAt != nil && now.Sub(*a.LastUsedAt) < time.Minute {
+		return false
+	}
+	a.LastUsedAt = &now
+	return true
+}
+
+func (a *APIKey) Principal() Principal {
+	return Principal{
+		UserID:   a.UserID,
+		APIKeyID: a.ID,
+		Scope:    a.Scope,
+		FolderID: a.FolderID,
+	}
+}
+
+func IsAPIKey(key string) bool {
+	return strings.HasPrefix(key, apiKeyPrefix)
+}
+
+func ParseAPIKey(key string) (string, string, error) {
+	prefix, secret, ok := strings.Cut(strings.TrimPrefix(key, apiKeyPrefix), "_")
+	if !IsAPIKey(key) || !ok || prefix == "" || secret == "" {
+		return "", "", ErrInvalidCredential
+	}
+	return prefix, secret, nil
+}
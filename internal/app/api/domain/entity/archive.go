@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"bytes"
+	"strings"
+)
+
+var (
+	ErrUnsupportedArchive = NewError(ErrUnsupportedMediaType, "unsupported_archive", "unsupported archive format")
+	ErrInvalidArchive     = NewValidationError("invalid_archive", "invalid archive")
+	ErrUnsafeArchivePath  = NewValidationError("unsafe_archive_path", "unsafe archive entry path")
+	ErrArchiveTooLarge    = NewValidationError("archive_too_large", "archive exceeds the extraction limit")
+)
+
+type ArchiveFormat string
+
+const (
+	ArchiveZip     ArchiveFormat = "zip"
+	ArchiveTar     ArchiveFormat = "tar"
+	ArchiveTarGzip ArchiveFormat = "tar.gz"
+	ArchiveTarZstd ArchiveFormat = "tar.zst"
+)
+
+func NewArchiveFormat(format string) (ArchiveFormat, error) {
+	switch ArchiveFormat(format) {
+	case ArchiveZip, ArchiveTarGzip:
+		return ArchiveFormat(format), nil
+	}
+	return "", NewValidationError("invalid_archive_format", "invalid archive format")
+}
+
+func (f ArchiveFormat) MimeType() string {
+	if f == ArchiveTarGzip {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+func DetectArchiveFormat(head []byte) (ArchiveFormat, error) {
+	switch {
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return ArchiveZip, nil
+	case bytes.HasPrefix(head, []byte("\x1f\x8b")):
+		return ArchiveTarGzip, nil
+	case bytes.HasPrefix(head, []byte("\x28\xb5\x2f\xfd")):
+		return ArchiveTarZstd, nil
+	case 262 <= len(head) && bytes.Equal(head[257:262], []byte("ustar")):
+		return ArchiveTar, nil
+	}
+	return "", ErrUnsupportedArchive
+}
+
+func SplitArchivePath(name string) ([]string, error) {
+	if name == "" || strings.HasPrefix(name, "/") || strings.Contains(name, "\\") {
+		return nil, ErrUnsafeArchivePath
+	}
+
+	elements := []string{}
+	for _, v := range strings.Split(name, "/") {
+		switch v {
+		case "", ".":
+			continue
+		case "..":
+			return nil, ErrUnsafeArchivePath
+		}
+		elements = append(elements, v)
+	}
+	return elements, nil
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "fmt"
+
+type AuthPolicy string
+
+const (
+	AuthPolicyPublic        AuthPolicy = "public"
+	AuthPolicyAuthenticated AuthPolicy = "authenticated"
+	AuthPolicyPrivate       AuthPolicy = "private"
+)
+
+func NewAuthPolicy(name string) (AuthPolicy, error) {
+	switch p := AuthPolicy(name); p {
+	case AuthPolicyPublic, AuthPolicyAuthenticated, AuthPolicyPrivate:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid auth policy: %s", name)
+}
+
+func (p AuthPolicy) AllowsAnonymous() bool {
+	return p != AuthPolicyPrivate
+}
+
+func (p AuthPolicy) DefaultPermission(userID uint64) Permission {
+	if userID == 0 {
+		if p.AllowsAnonymous() {
+			return PermissionRead
+		}
+		return PermissionNone
+	}
+	if p == AuthPolicyAuthenticated {
+		return PermissionWrite
+	}
+	return PermissionRead
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "testing"
+
+func TestNewAuthPolicy(t *testing.T) {
+	for _, v := range []struct {
+		name  string
+		valid bool
+	}{
+		{"public", true},
+		{"authenticated", true},
+		{"private", true},
+		{"", false},
+		{"open", false},
+	} {
+		policy, err := NewAuthPolicy(v.name)
+		if v.valid && (err != nil || string(policy) != v.name) {
+			t.Errorf("failed to parse auth policy %q: %v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Errorf("invalid auth policy %q is accepted", v.name)
+		}
+	}
+}
+
+func TestAuthPolicyDefaultPermission(t *testing.T) {
+	for _, v := range []struct {
+		policy     AuthPolicy
+		userID     uint64
+		permission Permission
+		anonymous  bool
+	}{
+		{AuthPolicyPublic, 0, PermissionRead, true},
+		{AuthPolicyPublic, 1, PermissionRead, true},
+		{AuthPolicyAuthenticated, 0, PermissionRead, true},
+		{AuthPolicyAuthenticated, 1, PermissionWrite, true},
+		{AuthPolicyPrivate, 0, PermissionNone, false},
+		{AuthPolicyPrivate, 1, PermissionRead, false},
+	} {
+		if permission := v.policy.DefaultPermission(v.userID); permission != v.permission {
+			t.Errorf("%s policy for user %d: expected %v, got %v", v.policy, v.userID, v.permission, permission)
+		}
+		if v.policy.AllowsAnonymous() != v.anonymous {
+			t.Errorf("%s policy: unexpected anonymous access", v.policy)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+var ErrConflict = fmt.Errorf("resource is already exists")
+
+type ConflictMode string
+
+const (
+	ConflictFail      ConflictMode = "fail"
+	ConflictOverwrite ConflictMode = "overwrite"
+	ConflictRename    ConflictMode = "rename"
+	ConflictSkip      ConflictMode = "skip"
+	ConflictMerge     ConflictMode = "merge"
+)
+
+func NewConflictMode(mode string) (ConflictMode, error) {
+	switch ConflictMode(mode) {
+	case ConflictFail, ConflictOverwrite, ConflictRename, ConflictSkip, ConflictMerge:
+		return ConflictMode(mode), nil
+	}
+	return "", NewValidationError("invalid_conflict_mode", "invalid conflict mode")
+}
+
+type ConflictError struct {
+	Path     string
+	FolderID *uint64
+	FileID   *uint64
+}
+
+func NewFolderConflictError(folder *FolderInfo) *ConflictError {
+	return &ConflictError{
+		Path:     folder.Path.Value,
+		FolderID: &folder.ID,
+	}
+}
+
+func NewFileConflictError(file *FileInfo) *ConflictError {
+	return &ConflictError{
+		Path:   file.Path.Value,
+		FileID: &file.ID,
+	}
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s is already exists", e.Path)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+func RenamedFolderName(name string, n int) string {
+	return fmt.Sprintf("%s (%d)", name, n)
+}
+
+func RenamedFileName(name string, n int) string {
+	ext := path.Ext(name)
+	if ext == name {
+		ext = ""
+	}
+	return fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), n, ext)
+}
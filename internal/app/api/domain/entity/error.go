@@ -0,0 +1,39 @@
+package entity
+
+import "fmt"
+
+var (
+	ErrValidation           = fmt.Errorf("validation failed")
+	ErrUnauthorized         = fmt.Errorf("unauthorized")
+	ErrForbidden            = fmt.Errorf("forbidden")
+	ErrNotFound             = fmt.Errorf("resource not found")
+	ErrGone                 = fmt.Errorf("resource is gone")
+	ErrUnsupportedMediaType = fmt.Errorf("unsupported media type")
+	ErrStorage              = fmt.Errorf("storage failure")
+)
+
+type Error struct {
+	Kind    error
+	Code    string
+	Message string
+}
+
+func NewError(kind error, code string, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Code:    code,
+		Message: message,
+	}
+}
+
+func NewValidationError(code string, message string) *Error {
+	return NewError(ErrValidation, code, message)
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
@@ -1,11 +1,13 @@
 package entity
 
 import (
-	"fmt"
+	"io"
 	"strings"
 	"time"
 )
 
+var ErrStorageQuotaExceeded = NewError(ErrStorage, "storage_quota_exceeded", "storage quota exceeded")
+
 type FileName struct {
 	Value string
 }
@@ -14,11 +16,11 @@ func NewFileName(name string) (*FileName, error) {
 	var invalidStrings = []string{"\\", "/", ":", "*", "?", "\"", "<", ">", "|"}
 	for _, v := range invalidStrings {
 		if strings.Contains(name, v) {
-			return nil, fmt.Errorf("invalid file name")
+			return nil, NewValidationError("invalid_file_name", "invalid file name")
 		}
 	}
 	if 128 < len(name) {
-		return nil, fmt.Errorf("file name is too long")
+		return nil, NewValidationError("file_name_too_long", "file name is too long")
 	}
 	return &FileName{
 		Value: name,
@@ -31,10 +33,10 @@ type FilePath struct {
 
 func NewFilePath(path string) (*FilePath, error) {
 	if path[:1] != "/" {
-		return nil, fmt.Errorf("invalid file path")
+		return nil, NewValidationError("invalid_file_path", "invalid file path")
 	}
 	if 255 < len(path) {
-		return nil, fmt.Errorf("file path is too long")
+		return nil, NewValidationError("file_path_too_long", "file path is too long")
 	}
 	return &FilePath{
 		Value: path,
@@ -47,10 +49,10 @@ type MimeType struct {
 
 func NewMimeType(mimeType string) (*MimeType, error) {
 	if !strings.Contains(mimeType, "/") {
-		return nil, fmt.Errorf("invalid mime type")
+		return nil, NewValidationError("invalid_mime_type", "invalid mime type")
 	}
 	if 64 < len(mimeType) {
-		return nil, fmt.Errorf("mime type is too long")
+		return nil, NewValidationError("mime_type_too_long", "mime type is too long")
 	}
 	return &MimeType{
 		Value: mimeType,
@@ -60,9 +62,11 @@ func NewMimeType(mimeType string) (*MimeType, error) {
 type FileInfo struct {
 	ID        uint64
 	FolderID  uint64
+	OwnerID   *uint64
 	Name      FileName
 	Path      FilePath
 	MimeType  MimeType
+	Size      int64
 	IsHide    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -123,21 +127,39 @@ func (f *FileInfo) Move(oldPath string, newPath string) error {
 }
 
 func (f *FileInfo) Copy(path string) (*FileInfo, error) {
-	return NewFileInfo(0, f.Name.Value, path, f.MimeType.Value, f.IsHide)
+	file, err := NewFileInfo(0, f.Name.Value, path, f.MimeType.Value, f.IsHide)
+	if err != nil {
+		return nil, err
+	}
+	file.Size = f.Size
+	return file, nil
 }
 
 type FileBody struct {
 	Path string
-	Body []byte
+	Body io.Reader
+	size int64
 }
 
-func NewFileBody(path string, body []byte) *FileBody {
-	return &FileBody{
+func NewFileBody(path string, body io.Reader) *FileBody {
+	file := &FileBody{
 		Path: path,
-		Body: body,
 	}
+	file.Body = &fileBodyReader{reader: body, file: file}
+	return file
+}
+
+func (f *FileBody) Size() int64 {
+	return f.size
+}
+
+type fileBodyReader struct {
+	reader io.Reader
+	file   *FileBody
 }
 
-func (f *FileBody) Copy(path string) *FileBody {
-	return NewFileBody(path, f.Body)
+func (r *fileBodyReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.file.size += int64(n)
+	return n, err
 }
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type FileVersion struct {
+	ID        uint64
+	FileID    uint64
+	MimeType  string
+	Size      int64
+	CreatedAt time.Time
+}
+
+func NewFileVersion(file *FileInfo) *FileVersion {
+	return &FileVersion{
+		FileID:    file.ID,
+		MimeType:  file.MimeType.Value,
+		Size:      file.Size,
+		CreatedAt: file.UpdatedAt,
+	}
+}
+
+func FileVersionDir(fileID uint64) string {
+	return fmt.Sprintf("/:versions/%d/", fileID)
+}
+
+func IsFileVersionPath(path string) bool {
+	return strings.HasPrefix(path, "/:versions/")
+}
+
+func (f *FileVersion) Path() string {
+	return fmt.Sprintf("%s%d", FileVersionDir(f.FileID), f.ID)
+}
@@ -1,7 +1,6 @@
 package entity
 
 import (
-	"fmt"
 	"strings"
 	"time"
 )
@@ -14,11 +13,11 @@ func NewFolderName(name string) (*FolderName, error) {
 	var invalidStrings = []string{"\\", "/", ":", "*", "?", "\"", "<", ">", "|"}
 	for _, v := range invalidStrings {
 		if strings.Contains(name, v) {
-			return nil, fmt.Errorf("invalid filder name")
+			return nil, NewValidationError("invalid_folder_name", "invalid folder name")
 		}
 	}
 	if 128 < len(name) {
-		return nil, fmt.Errorf("folder name is too long")
+		return nil, NewValidationError("folder_name_too_long", "folder name is too long")
 	}
 	return &FolderName{
 		Value: name,
@@ -31,10 +30,10 @@ type FolderPath struct {
 
 func NewFolderPath(path string) (*FolderPath, error) {
 	if path[:1] != "/" || path[len(path)-1:] != "/" {
-		return nil, fmt.Errorf("invalid folder path")
+		return nil, NewValidationError("invalid_folder_path", "invalid folder path")
 	}
 	if 255 < len(path) {
-		return nil, fmt.Errorf("folder path is too long")
+		return nil, NewValidationError("folder_path_too_long", "folder path is too long")
 	}
 	return &FolderPath{
 		Value: path,
@@ -44,6 +43,7 @@ func NewFolderPath(path string) (*FolderPath, error) {
 type FolderInfo struct {
 	ID             uint64
 	ParentFolderID *uint64
+	OwnerID        *uint64
 	Name           FolderName
 	Path           FolderPath
 	IsHide         bool
@@ -89,6 +89,16 @@ func (f *FolderInfo) SetPath(path string) error {
 	return nil
 }
 
+func (f *FolderInfo) SetOwner(ownerID *uint64) {
+	for i := 0; i < len(f.Folders); i++ {
+		f.Folders[i].SetOwner(ownerID)
+	}
+	for i := 0; i < len(f.Files); i++ {
+		f.Files[i].OwnerID = ownerID
+	}
+	f.OwnerID = ownerID
+}
+
 func (f *FolderInfo) IsRoot() bool {
 	return f.ParentFolderID == nil
 }
@@ -111,6 +121,17 @@ func (f *FolderInfo) Move(oldPath string, newPath string) error {
 	return f.SetPath(strings.Replace(f.Path.Value, oldPath, newPath, 1))
 }
 
+func (f *FolderInfo) LongestPathLength() int {
+	length := len(f.Path.Value)
+	for _, v := range f.Folders {
+		length = max(length, v.LongestPathLength())
+	}
+	for _, v := range f.Files {
+		length = max(length, len(v.Path.Value))
+	}
+	return length
+}
+
 func (f *FolderInfo) Copy(path string) (*FolderInfo, error) {
 	folder, err := NewFolderInfo(nil, f.Name.Value, path, f.IsHide)
 	if err != nil {
@@ -145,9 +166,7 @@ func (f *FolderInfo) Copy(path string) (*FolderInfo, error) {
 }
 
 type FolderBody struct {
-	Path    string
-	Folders []FolderBody
-	Files   []FileBody
+	Path string
 }
 
 func NewFolderBody(path string) *FolderBody {
@@ -155,31 +174,3 @@ func NewFolderBody(path string) *FolderBody {
 		Path: path,
 	}
 }
-
-func (f *FolderBody) Copy(path string) *FolderBody {
-	folder := NewFolderBody(path)
-
-	folders := make([]FolderBody, len(f.Folders))
-	if 0 < len(f.Folders) {
-		for i, v := range f.Folders {
-			folderPath := v.Path
-			folderName := folderPath[strings.LastIndex(folderPath[:len(folderPath)-1], "/")+1:]
-			folder := v.Copy(path + folderName + "/")
-			folders[i] = *folder
-		}
-		folder.Folders = folders
-	}
-
-	files := make([]FileBody, len(f.Files))
-	if 0 < len(f.Files) {
-		for i, v := range f.Files {
-			filePath := v.Path
-			fileName := filePath[strings.LastIndex(filePath, "/")+1:]
-			file := v.Copy(path + fileName)
-			files[i] = *file
-		}
-		folder.Files = files
-	}
-
-	return folder
-}
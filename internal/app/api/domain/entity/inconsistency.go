@@ -0,0 +1,76 @@
+package entity
+
+import "strings"
+
+type InconsistencyKind string
+
+const (
+	InconsistencyMissingBody   InconsistencyKind = "missing_body"
+	InconsistencyMissingFolder InconsistencyKind = "missing_folder"
+	InconsistencyOrphanBody    InconsistencyKind = "orphan_body"
+	InconsistencyOrphanFolder  InconsistencyKind = "orphan_folder"
+	InconsistencyWrongPath     InconsistencyKind = "wrong_path"
+	InconsistencySizeMismatch  InconsistencyKind = "size_mismatch"
+)
+
+type RepairAction string
+
+const (
+	RepairRemoveRow  RepairAction = "remove_row"
+	RepairCreateBody RepairAction = "create_body"
+	RepairImport     RepairAction = "import"
+	RepairUpdatePath RepairAction = "update_path"
+	RepairUpdateSize RepairAction = "update_size"
+)
+
+type Inconsistency struct {
+	Kind         InconsistencyKind
+	ID           uint64
+	Path         string
+	ExpectedPath string
+	BodyPath     string
+	Reason       string
+}
+
+func NewInconsistency(kind InconsistencyKind, id uint64, path string, reason string) *Inconsistency {
+	return &Inconsistency{
+		Kind:   kind,
+		ID:     id,
+		Path:   path,
+		Reason: reason,
+	}
+}
+
+func (i *Inconsistency) IsFolder() bool {
+	return strings.HasSuffix(i.Path, "/")
+}
+
+func (i *Inconsistency) Action() RepairAction {
+	switch i.Kind {
+	case InconsistencyMissingBody:
+		return RepairRemoveRow
+	case InconsistencyMissingFolder:
+		return RepairCreateBody
+	case InconsistencyOrphanBody, InconsistencyOrphanFolder:
+		return RepairImport
+	case InconsistencyWrongPath:
+		return RepairUpdatePath
+	case InconsistencySizeMismatch:
+		return RepairUpdateSize
+	}
+	return ""
+}
+
+func IsReservedPath(path string) bool {
+	return strings.HasPrefix(path, "/:")
+}
+
+func ParentPath(path string) string {
+	path = strings.TrimSuffix(path, "/")
+	return path[:strings.LastIndex(path, "/")+1]
+}
+
+func BaseName(path string) string {
+	path = strings.TrimSuffix(path, "/")
+	return path[strings.LastIndex(path, "/")+1:]
+}
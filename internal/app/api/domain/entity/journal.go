@@ -0,0 +1,62 @@
+package entity
+
+import "time"
+
+type JournalStatus string
+
+const (
+	JournalStatusPending   JournalStatus = "pending"
+	JournalStatusCommitted JournalStatus = "committed"
+)
+
+type JournalOperationKind string
+
+const (
+	JournalCreateFolder JournalOperationKind = "create_folder"
+	JournalMoveFolder   JournalOperationKind = "move_folder"
+	JournalRemoveFolder JournalOperationKind = "remove_folder"
+	JournalCreateFile   JournalOperationKind = "create_file"
+	JournalMoveFile     JournalOperationKind = "move_file"
+	JournalRemoveFile   JournalOperationKind = "remove_file"
+	JournalCopyFile     JournalOperationKind = "copy_file"
+)
+
+type JournalOperation struct {
+	Kind    JournalOperationKind `json:"kind"`
+	Path    string               `json:"path"`
+	NewPath string               `json:"new_path,omitempty"`
+}
+
+func (o *JournalOperation) IsDeferred() bool {
+	return o.Kind == JournalRemoveFolder || o.Kind == JournalRemoveFile
+}
+
+type Journal struct {
+	ID         uint64
+	Status     JournalStatus
+	Operations []JournalOperation
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewJournal() *Journal {
+	return &Journal{
+		Status: JournalStatusPending,
+	}
+}
+
+func (j *Journal) Stage(operation JournalOperation) {
+	j.Operations = append(j.Operations, operation)
+}
+
+func (j *Journal) Unstage() {
+	j.Operations = j.Operations[:len(j.Operations)-1]
+}
+
+func (j *Journal) Commit() {
+	j.Status = JournalStatusCommitted
+}
+
+func (j *Journal) IsCommitted() bool {
+	return j.Status == JournalStatusCommitted
+}
@@ -0,0 +1,231 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const MaxListLimit = 1000
+
+var ErrInvalidCursor = NewValidationError("invalid_cursor", "invalid cursor")
+
+type ListSort string
+
+const (
+	ListSortName      ListSort = "name"
+	ListSortSize      ListSort = "size"
+	ListSortMimeType  ListSort = "mime_type"
+	ListSortCreatedAt ListSort = "created_at"
+	ListSortUpdatedAt ListSort = "updated_at"
+)
+
+func NewListSort(sort string) (ListSort, error) {
+	switch ListSort(sort) {
+	case ListSortName, ListSortSize, ListSortMimeType, ListSortCreatedAt, ListSortUpdatedAt:
+		return ListSort(sort), nil
+	}
+	return "", NewValidationError("invalid_sort", "invalid sort")
+}
+
+func (s ListSort) Column(kind ListKind) string {
+	if kind == ListKindFolder && (s == ListSortSize || s == ListSortMimeType) {
+		return string(ListSortName)
+	}
+	return string(s)
+}
+
+type ListOrder string
+
+const (
+	ListOrderAsc  ListOrder = "asc"
+	ListOrderDesc ListOrder = "desc"
+)
+
+func NewListOrder(order string) (ListOrder, error) {
+	switch ListOrder(order) {
+	case ListOrderAsc, ListOrderDesc:
+		return ListOrder(order), nil
+	}
+	return "", NewValidationError("invalid_order", "invalid order")
+}
+
+type ListKind string
+
+const (
+	ListKindFolder ListKind = "folder"
+	ListKindFile   ListKind = "file"
+)
+
+type ListCursor struct {
+	Kind  ListKind `json:"kind"`
+	Value string   `json:"value,omitempty"`
+	ID    uint64   `json:"id,omitempty"`
+}
+
+func NewListCursor(cursor string) (*ListCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var listCursor ListCursor
+	if err := json.Unmarshal(b, &listCursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if listCursor.Kind != ListKindFolder && listCursor.Kind != ListKindFile {
+		return nil, ErrInvalidCursor
+	}
+	return &listCursor, nil
+}
+
+func NewFolderListCursor(folder *FolderInfo, sort ListSort) *ListCursor {
+	var value string
+	switch sort.Column(ListKindFolder) {
+	case string(ListSortCreatedAt):
+		value = folder.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case string(ListSortUpdatedAt):
+		value = folder.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		value = folder.Name.Value
+	}
+	return &ListCursor{Kind: ListKindFolder, Value: value, ID: folder.ID}
+}
+
+func NewFileListCursor(file *FileInfo, sort ListSort) *ListCursor {
+	var value string
+	switch sort {
+	case ListSortSize:
+		value = strconv.FormatInt(file.Size, 10)
+	case ListSortMimeType:
+		value = file.MimeType.Value
+	case ListSortCreatedAt:
+		value = file.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case ListSortUpdatedAt:
+		value = file.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		value = file.Name.Value
+	}
+	return &ListCursor{Kind: ListKindFile, Value: value, ID: file.ID}
+}
+
+func (c *ListCursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+type Visibility struct {
+	IncludeHidden bool
+	OwnerID       *uint64
+	FolderIDs     []uint64
+}
+
+type ListFilter struct {
+	Kind          ListKind
+	Path          string
+	Name          string
+	MimeType      string
+	MinSize       *int64
+	MaxSize       *int64
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	Visibility    Visibility
+}
+
+func (f *ListFilter) Includes(kind ListKind) bool {
+	if f.Kind != "" && f.Kind != kind {
+		return false
+	}
+	if kind == ListKindFolder {
+		return f.MimeType == "" && f.MinSize == nil && f.MaxSize == nil
+	}
+	return true
+}
+
+type ListQuery struct {
+	Sort   ListSort
+	Order  ListOrder
+	Filter ListFilter
+	Cursor *ListCursor
+	Limit  int
+}
+
+func NewListQuery(sort string, order string, cursor string, limit int, filter ListFilter) (*ListQuery, error) {
+	listSort, err := NewListSort(sort)
+	if err != nil {
+		return nil, err
+	}
+	listOrder, err := NewListOrder(order)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 || MaxListLimit < limit {
+		return nil, NewValidationError("invalid_limit", "invalid limit")
+	}
+	if filter.Kind != "" && filter.Kind != ListKindFolder && filter.Kind != ListKindFile {
+		return nil, NewValidationError("invalid_type", "invalid type")
+	}
+	if 128 < len(filter.Name) {
+		return nil, NewValidationError("name_too_long", "name is too long")
+	}
+	if 64 < len(filter.MimeType) {
+		return nil, NewValidationError("mime_type_too_long", "mime type is too long")
+	}
+	if filter.MinSize != nil && filter.MaxSize != nil && *filter.MaxSize < *filter.MinSize {
+		return nil, NewValidationError("invalid_size_range", "invalid size range")
+	}
+
+	query := &ListQuery{
+		Sort:   listSort,
+		Order:  listOrder,
+		Filter: filter,
+		Limit:  limit,
+	}
+	if cursor != "" {
+		if query.Cursor, err = NewListCursor(cursor); err != nil {
+			return nil, err
+		}
+		if query.Cursor.ID != 0 {
+			if _, err := query.CursorValue(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return query, nil
+}
+
+func (q *ListQuery) CursorValue() (interface{}, error) {
+	switch q.Sort.Column(q.Cursor.Kind) {
+	case string(ListSortSize):
+		size, err := strconv.ParseInt(q.Cursor.Value, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return size, nil
+	case string(ListSortCreatedAt), string(ListSortUpdatedAt):
+		t, err := time.Parse(time.RFC3339Nano, q.Cursor.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return t, nil
+	}
+	return q.Cursor.Value, nil
+}
+
+func (q *ListQuery) With(kind ListKind, limit int) *ListQuery {
+	query := *q
+	if query.Cursor != nil && query.Cursor.Kind != kind {
+		query.Cursor = nil
+	}
+	query.Limit = limit
+	return &query
+}
+
+func (q *ListQuery) Includes(kind ListKind) bool {
+	if !q.Filter.Includes(kind) {
+		return false
+	}
+	return kind == ListKindFile || q.Cursor == nil || q.Cursor.Kind == ListKindFolder
+}
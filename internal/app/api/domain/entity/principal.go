@@ -0,0 +1,17 @@
+package entity
+
+type Principal struct {
+	UserID      uint64
+	APIKeyID    uint64
+	ShareLinkID uint64
+	Scope       Permission
+	FolderID    *uint64
+}
+
+func (p Principal) IsAPIKey() bool {
+	return p.APIKeyID != 0
+}
+
+func (p Principal) IsShareLink() bool {
+	return p.ShareLinkID != 0
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrShareLinkExpired      = NewError(ErrGone, "share_link_expired", "share link is expired")
+	ErrShareLinkExhausted    = NewError(ErrGone, "share_link_exhausted", "share link download limit is reached")
+	ErrSharePasswordRequired = NewError(ErrUnauthorized, "share_password_required", "share link password is required")
+	ErrInvalidSharePassword  = NewError(ErrUnauthorized, "invalid_share_password", "invalid share link password")
+)
+
+type ShareLink struct {
+	ID            uint64
+	Token         string
+	UserID        uint64
+	FileID        *uint64
+	FolderID      *uint64
+	Password      string
+	ExpiresAt     *time.Time
+	MaxDownloads  *uint64
+	DownloadCount uint64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewShareLink(userID uint64, fileID *uint64, folderID *uint64, password string, expiresAt *time.Time, maxDownloads *uint64) (*ShareLink, error) {
+	if (fileID == nil) == (folderID == nil) {
+		return nil, NewValidationError("share_target_required", "either file id or folder id is required")
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, NewValidationError("invalid_expiration", "invalid expiration")
+	}
+	if maxDownloads != nil && *maxDownloads == 0 {
+		return nil, NewValidationError("invalid_max_downloads", "invalid max downloads")
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	shareLink := &ShareLink{
+		Token:        base64.RawURLEncoding.EncodeToString(token),
+		UserID:       userID,
+		FileID:       fileID,
+		FolderID:     folderID,
+		ExpiresAt:    expiresAt,
+		MaxDownloads: maxDownloads,
+	}
+	if err := shareLink.SetPassword(password); err != nil {
+		return nil, err
+	}
+	return shareLink, nil
+}
+
+func (s *ShareLink) SetPassword(password string) error {
+	if password == "" {
+		s.Password = ""
+		return nil
+	}
+	if 72 < len(password) {
+		return NewValidationError("password_too_long", "password is too long")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	s.Password = string(hash)
+	return nil
+}
+
+func (s *ShareLink) HasPassword() bool {
+	return s.Password != ""
+}
+
+func (s *ShareLink) Authenticate(password string, now time.Time) error {
+	if s.ExpiresAt != nil && !now.Before(*s.ExpiresAt) {
+		return ErrShareLinkExpired
+	}
+	if !s.HasPassword() {
+		return nil
+	}
+	if password == "" {
+		return ErrSharePasswordRequired
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(s.Password), []byte(password)); err != nil {
+		return ErrInvalidSharePassword
+	}
+	return nil
+}
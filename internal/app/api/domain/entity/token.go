@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+var (
+	ErrInvalidToken = NewError(ErrUnauthorized, "invalid_token", "invalid token")
+	ErrTokenRevoked = NewError(ErrUnauthorized, "token_revoked", "token is revoked")
+)
+
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+type RevokedToken struct {
+	ID        string
+	UserID    uint64
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewRevokedToken(id string, userID uint64, expiresAt time.Time) *RevokedToken {
+	return &RevokedToken{
+		ID:        id,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	ErrRestoreConflict  = NewError(ErrConflict, "restore_conflict", "restore destination is not available")
+	ErrTrashPathTooLong = NewValidationError("trash_path_too_long", "path is too long to move to the trash")
+)
+
+type TrashItem struct {
+	ID             uint64
+	UserID         *uint64
+	FolderID       *uint64
+	FileID         *uint64
+	ParentFolderID *uint64
+	Name           string
+	Path           string
+	CreatedAt      time.Time
+}
+
+func NewFolderTrashItem(userID uint64, folder *FolderInfo) *TrashItem {
+	return &TrashItem{
+		UserID:         &userID,
+		FolderID:       &folder.ID,
+		ParentFolderID: folder.ParentFolderID,
+		Name:           folder.Name.Value,
+		Path:           folder.Path.Value,
+	}
+}
+
+func NewFileTrashItem(userID uint64, file *FileInfo) *TrashItem {
+	return &TrashItem{
+		UserID:         &userID,
+		FileID:         &file.ID,
+		ParentFolderID: &file.FolderID,
+		Name:           file.Name.Value,
+		Path:           file.Path.Value,
+	}
+}
+
+func (t *TrashItem) IsFolder() bool {
+	return t.FolderID != nil
+}
+
+func (t *TrashItem) Dir() string {
+	return fmt.Sprintf("/:trash/%d/", t.ID)
+}
+
+func (t *TrashItem) TrashPath() string {
+	if t.IsFolder() {
+		return t.Dir() + t.Name + "/"
+	}
+	return t.Dir() + t.Name
+}
+
+// CheckPathLength checks that a path of the given length under the trashed
+// item still fits once it is re-rooted under Dir.
+func (t *TrashItem) CheckPathLength(length int) error {
+	if 255 < length-len(t.Path)+len(t.TrashPath()) {
+		return ErrTrashPathTooLong
+	}
+	return nil
+}
+
+func (t *TrashItem) RenamedName(n int) string {
+	if t.IsFolder() {
+		return RenamedFolderName(t.Name, n)
+	}
+	return RenamedFileName(t.Name, n)
+}
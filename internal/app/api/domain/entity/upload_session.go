@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+var (
+	ErrUploadOffsetMismatch = NewError(ErrConflict, "upload_offset_mismatch", "upload offset mismatch")
+	ErrUploadIncomplete     = NewError(ErrConflict, "upload_incomplete", "upload is not completed")
+	ErrInvalidContentType   = NewError(ErrUnsupportedMediaType, "invalid_content_type", "invalid content type")
+)
+
+type UploadSession struct {
+	ID        string
+	UserID    uint64
+	FolderID  uint64
+	Name      FileName
+	Size      int64
+	Offset    int64
+	IsHide    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewUploadSession(userID uint64, folderID uint64, name string, size int64, isHide bool) (*UploadSession, error) {
+	fileName, err := NewFileName(name)
+	if err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return nil, NewValidationError("invalid_upload_size", "invalid upload size")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &UploadSession{
+		ID:       hex.EncodeToString(id),
+		UserID:   userID,
+		FolderID: folderID,
+		Name:     *fileName,
+		Size:     size,
+		IsHide:   isHide,
+	}, nil
+}
+
+func (u *UploadSession) SetName(name string) error {
+	fileName, err := NewFileName(name)
+	if err != nil {
+		return err
+	}
+	u.Name = *fileName
+	return nil
+}
+
+func (u *UploadSession) Remaining() int64 {
+	return u.Size - u.Offset
+}
+
+func (u *UploadSession) IsCompleted() bool {
+	return u.Offset == u.Size
+}
+
+func (u *UploadSession) Append(offset int64, n int64) error {
+	if offset != u.Offset {
+		return ErrUploadOffsetMismatch
+	}
+	if u.Remaining() < n {
+		return NewValidationError("upload_size_exceeded", "upload size exceeded")
+	}
+	u.Offset += n
+	return nil
+}
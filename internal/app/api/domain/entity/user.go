@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"regexp"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredential = NewError(ErrUnauthorized, "invalid_credential", "invalid credential")
+	ErrUserDisabled      = NewError(ErrUnauthorized, "user_disabled", "user is disabled")
+)
+
+var userNamePattern = regexp.MustCompile(`^[0-9A-Za-z_.-]+$`)
+
+type UserName struct {
+	Value string
+}
+
+func NewUserName(name string) (*UserName, error) {
+	if !userNamePattern.MatchString(name) {
+		return nil, NewValidationError("invalid_user_name", "invalid user name")
+	}
+	if 64 < len(name) {
+		return nil, NewValidationError("user_name_too_long", "user name is too long")
+	}
+	return &UserName{
+		Value: name,
+	}, nil
+}
+
+type User struct {
+	ID               uint64
+	Name             UserName
+	Password         string
+	IsAdmin          bool
+	IsDisabled       bool
+	TokensValidAfter *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewUser(name string, password string, isAdmin bool) (*User, error) {
+	userName, err := NewUserName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{
+		Name:    *userName,
+		IsAdmin: isAdmin,
+	}
+	if err := user.SetPassword(password); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u *User) SetName(name string) error {
+	userName, err := NewUserName(name)
+	if err != nil {
+		return err
+	}
+	u.Name = *userName
+	return nil
+}
+
+func (u *User) SetPassword(password string) error {
+	if len(password) < 8 {
+		return NewValidationError("password_too_short", "password is too short")
+	}
+	if 72 < len(password) {
+		return NewValidationError("password_too_long", "password is too long")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hash)
+	return nil
+}
+
+func (u *User) RevokeTokens() {
+	validAfter := time.Now().Truncate(time.Second).Add(time.Second)
+	u.TokensValidAfter = &validAfter
+}
+
+func (u *User) IsTokenValid(issuedAt time.Time) bool {
+	return u.TokensValidAfter == nil || !issuedAt.Before(*u.TokensValidAfter)
+}
+
+func (u *User) Authenticate(password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
+		return ErrInvalidCredential
+	}
+	if u.IsDisabled {
+		return ErrUserDisabled
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type AccessControlRepository interface {
+	Create(*gorm.DB, *entity.AccessControlEntry) (*entity.AccessControlEntry, error)
+	Update(*gorm.DB, *entity.AccessControlEntry) (*entity.AccessControlEntry, error)
+	Remove(*gorm.DB, *entity.AccessControlEntry) error
+	FindAllByFolderID(*gorm.DB, uint64) ([]entity.AccessControlEntry, error)
+	FindAllByUserID(*gorm.DB, uint64) ([]entity.AccessControlEntry, error)
+	FindOneByFolderIDAndUserID(*gorm.DB, uint64, uint64) (*entity.AccessControlEntry, error)
+}
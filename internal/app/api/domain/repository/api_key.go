@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	Create(*gorm.DB, *entity.APIKey) (*entity.APIKey, error)
+	Update(*gorm.DB, *entity.APIKey) (*entity.APIKey, error)
+	Remove(*gorm.DB, *entity.APIKey) error
+	FindAllByUserID(*gorm.DB, uint64) ([]entity.APIKey, error)
+	FindOneByID(*gorm.DB, uint64) (*entity.APIKey, error)
+	FindOneByPrefix(*gorm.DB, string) (*entity.APIKey, error)
+}
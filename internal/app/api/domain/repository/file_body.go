@@ -1,10 +1,18 @@
 package repository
 
-import "file-server/internal/app/api/domain/entity"
+import (
+	"file-server/internal/app/api/domain/entity"
+	"io"
+
+	"gorm.io/gorm"
+)
 
 type FileBodyRepository interface {
-	Create(*entity.FileBody) error
-	Update(string, string) error
-	Remove(string) error
-	Read(string) (*entity.FileBody, error)
+	Create(*gorm.DB, *entity.FileBody) error
+	Update(*gorm.DB, string, string) error
+	Remove(*gorm.DB, string) error
+	Copy(*gorm.DB, string, string) error
+	Read(string) (io.ReadSeekCloser, error)
+	FindAll() ([]string, error)
+	PresignedURL(string, string) (string, error)
 }
@@ -11,7 +11,13 @@ type FileInfoRepository interface {
 	Creates(*gorm.DB, []entity.FileInfo) ([]entity.FileInfo, error)
 	Update(*gorm.DB, *entity.FileInfo) (*entity.FileInfo, error)
 	Remove(*gorm.DB, *entity.FileInfo) error
+	TrashByPathPrefix(*gorm.DB, string) error
+	RestoreByPathPrefix(*gorm.DB, string) error
+	FindAll(*gorm.DB) ([]entity.FileInfo, error)
 	FindOneByID(*gorm.DB, uint64) (*entity.FileInfo, error)
 	FindOneByIDAndIsHide(*gorm.DB, uint64, bool) (*entity.FileInfo, error)
 	FindOneByPath(*gorm.DB, string) (*entity.FileInfo, error)
+	FindAllByFolderID(*gorm.DB, uint64, *entity.ListQuery) ([]entity.FileInfo, error)
+	Search(*gorm.DB, *entity.ListQuery) ([]entity.FileInfo, error)
+	CountByFolderID(*gorm.DB, uint64, *entity.ListFilter) (int64, error)
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type FileVersionRepository interface {
+	Create(*gorm.DB, *entity.FileVersion) (*entity.FileVersion, error)
+	Update(*gorm.DB, *entity.FileVersion) (*entity.FileVersion, error)
+	Remove(*gorm.DB, *entity.FileVersion) error
+	FindAll(*gorm.DB) ([]entity.FileVersion, error)
+	FindAllByFileID(*gorm.DB, uint64) ([]entity.FileVersion, error)
+	FindOneByID(*gorm.DB, uint64) (*entity.FileVersion, error)
+}
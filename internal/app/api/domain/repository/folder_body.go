@@ -1,10 +1,14 @@
 package repository
 
-import "file-server/internal/app/api/domain/entity"
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
 
 type FolderBodyRepository interface {
-	Create(*entity.FolderBody) error
-	Update(string, string) error
-	Remove(string) error
-	Read(string) (*entity.FolderBody, error)
+	Create(*gorm.DB, *entity.FolderBody) error
+	Update(*gorm.DB, string, string) error
+	Remove(*gorm.DB, string) error
+	FindAll() ([]string, error)
 }
@@ -8,10 +8,18 @@ import (
 
 type FolderInfoRepository interface {
 	Create(*gorm.DB, *entity.FolderInfo) (*entity.FolderInfo, error)
+	Creates(*gorm.DB, []entity.FolderInfo) ([]entity.FolderInfo, error)
 	Update(*gorm.DB, *entity.FolderInfo) (*entity.FolderInfo, error)
 	Remove(*gorm.DB, *entity.FolderInfo) error
+	TrashByPathPrefix(*gorm.DB, string) error
+	RestoreByPathPrefix(*gorm.DB, string) error
+	FindAll(*gorm.DB) ([]entity.FolderInfo, error)
 	FindOneByID(*gorm.DB, uint64) (*entity.FolderInfo, error)
 	FindOneByPath(*gorm.DB, string) (*entity.FolderInfo, error)
+	FindAllByPaths(*gorm.DB, []string) ([]entity.FolderInfo, error)
+	FindAllByParentFolderID(*gorm.DB, uint64, *entity.ListQuery) ([]entity.FolderInfo, error)
+	Search(*gorm.DB, *entity.ListQuery) ([]entity.FolderInfo, error)
+	CountByParentFolderID(*gorm.DB, uint64, *entity.ListFilter) (int64, error)
 	FindOneByPathWithChildren(*gorm.DB, string) (*entity.FolderInfo, error)
 	FindOneByPathAndIsHideWithChildren(*gorm.DB, string, bool) (*entity.FolderInfo, error)
 	FindOneByIDWithLower(*gorm.DB, uint64) (*entity.FolderInfo, error)
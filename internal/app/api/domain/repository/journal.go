@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type JournalRepository interface {
+	Create(*gorm.DB, *entity.Journal) (*entity.Journal, error)
+	Update(*gorm.DB, *entity.Journal) (*entity.Journal, error)
+	Remove(*gorm.DB, *entity.Journal) error
+	FindAll(*gorm.DB) ([]entity.Journal, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RevokedTokenRepository interface {
+	Create(*gorm.DB, *entity.RevokedToken) (*entity.RevokedToken, error)
+	RemoveByExpiresAtBefore(*gorm.DB, time.Time) error
+	FindOneByID(*gorm.DB, string) (*entity.RevokedToken, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type ShareLinkRepository interface {
+	Create(*gorm.DB, *entity.ShareLink) (*entity.ShareLink, error)
+	Remove(*gorm.DB, *entity.ShareLink) error
+	IncrementDownloadCount(*gorm.DB, *entity.ShareLink) (bool, error)
+	FindAllByUserID(*gorm.DB, uint64) ([]entity.ShareLink, error)
+	FindOneByID(*gorm.DB, uint64) (*entity.ShareLink, error)
+	FindOneByToken(*gorm.DB, string) (*entity.ShareLink, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TrashItemRepository interface {
+	Create(*gorm.DB, *entity.TrashItem) (*entity.TrashItem, error)
+	Remove(*gorm.DB, *entity.TrashItem) error
+	FindAllByUserID(*gorm.DB, uint64) ([]entity.TrashItem, error)
+	FindAllByParentFolderIDs(*gorm.DB, []uint64) ([]entity.TrashItem, error)
+	FindAllByCreatedAtBefore(*gorm.DB, time.Time) ([]entity.TrashItem, error)
+	FindOneByID(*gorm.DB, uint64) (*entity.TrashItem, error)
+}
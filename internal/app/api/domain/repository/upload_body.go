@@ -0,0 +1,10 @@
+package repository
+
+import "io"
+
+type UploadBodyRepository interface {
+	Create(string) error
+	Write(string, int64, io.Reader) (int64, error)
+	Remove(string) error
+	Read(string) (io.ReadCloser, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UploadSessionRepository interface {
+	Create(*gorm.DB, *entity.UploadSession) (*entity.UploadSession, error)
+	Update(*gorm.DB, *entity.UploadSession) (*entity.UploadSession, error)
+	Remove(*gorm.DB, *entity.UploadSession) error
+	FindOneByID(*gorm.DB, string) (*entity.UploadSession, error)
+	FindOneByIDWithLock(*gorm.DB, string) (*entity.UploadSession, error)
+	FindByUpdatedAtBefore(*gorm.DB, time.Time) ([]entity.UploadSession, error)
+}
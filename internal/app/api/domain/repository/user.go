@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"file-server/internal/app/api/domain/entity"
+
+	"gorm.io/gorm"
+)
+
+type UserRepository interface {
+	Create(*gorm.DB, *entity.User) (*entity.User, error)
+	Update(*gorm.DB, *entity.User) (*entity.User, error)
+	Remove(*gorm.DB, *entity.User) error
+	FindAll(*gorm.DB) ([]entity.User, error)
+	FindOneByID(*gorm.DB, uint64) (*entity.User, error)
+	FindOneByName(*gorm.DB, string) (*entity.User, error)
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type AccessControlService interface {
+	FolderPermission(*gorm.DB, entity.Principal, *entity.FolderInfo) (entity.Permission, error)
+	FilePermission(*gorm.DB, entity.Principal, *entity.FileInfo) (entity.Permission, error)
+	Filter(*gorm.DB, entity.Principal, *entity.FolderInfo) error
+	Visibility(*gorm.DB, entity.Principal, *entity.FolderInfo) (*entity.Visibility, error)
+	FilterReadable(*gorm.DB, entity.Principal, *entity.FolderInfo, []entity.FolderInfo, []entity.FileInfo) ([]entity.FolderInfo, []entity.FileInfo, error)
+}
+
+type accessControlService struct {
+	accessControlRepository repository.AccessControlRepository
+	folderInfoRepository    repository.FolderInfoRepository
+	userRepository          repository.UserRepository
+	policy                  entity.AuthPolicy
+}
+
+func NewAccessControlService(accessControlRepository repository.AccessControlRepository, folderInfoRepository repository.FolderInfoRepository, userRepository repository.UserRepository, policy entity.AuthPolicy) AccessControlService {
+	return &accessControlService{
+		accessControlRepository: accessControlRepository,
+		folderInfoRepository:    folderInfoRepository,
+		userRepository:          userRepository,
+		policy:                  policy,
+	}
+}
+
+func (as *accessControlService) FolderPermission(db *gorm.DB, principal entity.Principal, folder *entity.FolderInfo) (entity.Permission, error) {
+	acl, inherited, err := as.load(db, principal, folder.Path.Value)
+	if err != nil {
+		return entity.PermissionNone, err
+	}
+	return acl.FolderPermission(folder, inherited), nil
+}
+
+func (as *accessControlService) FilePermission(db *gorm.DB, principal entity.Principal, file *entity.FileInfo) (entity.Permission, error) {
+	acl, inherited, err := as.load(db, principal, file.Path.Value)
+	if err != nil {
+		return entity.PermissionNone, err
+	}
+	return acl.FilePermission(file, inherited), nil
+}
+
+func (as *accessControlService) Filter(db *gorm.DB, principal entity.Principal, folder *entity.FolderInfo) error {
+	acl, inherited, err := as.load(db, principal, folder.Path.Value)
+	if err != nil {
+		return err
+	}
+	acl.Filter(folder, inherited)
+	return nil
+}
+
+func (as *accessControlService) Visibility(db *gorm.DB, principal entity.Principal, folder *entity.FolderInfo) (*entity.Visibility, error) {
+	acl, inherited, err := as.load(db, principal, folder.Path.Value)
+	if err != nil {
+		return nil, err
+	}
+	visibility := acl.Visibility(folder, inherited)
+	return &visibility, nil
+}
+
+func (as *accessControlService) FilterReadable(db *gorm.DB, principal entity.Principal, root *entity.FolderInfo, folders []entity.FolderInfo, files []entity.FileInfo) ([]entity.FolderInfo, []entity.FileInfo, error) {
+	acl, inherited, err := as.load(db, principal, root.Path.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var paths []string
+	seen := make(map[string]bool)
+	for _, v := range append(folderPaths(folders), filePaths(files)...) {
+		for _, p := range entity.AncestorPaths(v) {
+			if len(root.Path.Value) < len(p) && !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+	}
+	ancestors := make(map[string]entity.FolderInfo)
+	if 0 < len(paths) {
+		found, err := as.folderInfoRepository.FindAllByPaths(db, paths)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, v := range found {
+			ancestors[v.Path.Value] = v
+		}
+	}
+
+	readableFolders := make([]entity.FolderInfo, 0, len(folders))
+	for _, v := range folders {
+		if grant, ok := acl.Traverse(root, inherited, v.Path.Value, ancestors); ok && acl.FolderPermission(&v, grant).Allows(entity.PermissionRead) {
+			readableFolders = append(readableFolders, v)
+		}
+	}
+	readableFiles := make([]entity.FileInfo, 0, len(files))
+	for _, v := range files {
+		if grant, ok := acl.Traverse(root, inherited, v.Path.Value, ancestors); ok && acl.FilePermission(&v, grant).Allows(entity.PermissionRead) {
+			readableFiles = append(readableFiles, v)
+		}
+	}
+	return readableFolders, readableFiles, nil
+}
+
+func (as *accessControlService) load(db *gorm.DB, principal entity.Principal, path string) (*entity.AccessControlList, entity.Permission, error) {
+	if principal.UserID == 0 {
+		return entity.NewAccessControlList(as.policy, nil, nil), entity.PermissionNone, nil
+	}
+
+	user, err := as.userRepository.FindOneByID(db, principal.UserID)
+	if err != nil {
+		return nil, entity.PermissionNone, err
+	}
+	entries, err := as.accessControlRepository.FindAllByUserID(db, principal.UserID)
+	if err != nil {
+		return nil, entity.PermissionNone, err
+	}
+	acl := entity.NewAccessControlList(as.policy, user, entries)
+	if principal.IsAPIKey() || principal.IsShareLink() {
+		root := ""
+		if principal.FolderID != nil {
+			folder, err := as.folderInfoRepository.FindOneByID(db, *principal.FolderID)
+			if err != nil {
+				return nil, entity.PermissionNone, err
+			}
+			root = folder.Path.Value
+		}
+		acl.Restrict(principal.Scope, root)
+	}
+	if acl.IsAdmin {
+		return acl, entity.PermissionAdmin, nil
+	}
+
+	ancestors, err := as.folderInfoRepository.FindAllByPaths(db, entity.AncestorPaths(path))
+	if err != nil {
+		return nil, entity.PermissionNone, err
+	}
+	inherited := entity.PermissionNone
+	for _, v := range ancestors {
+		inherited = acl.Grant(&v, inherited)
+	}
+	return acl, inherited, nil
+}
+
+func folderPaths(folders []entity.FolderInfo) []string {
+	paths := make([]string, len(folders))
+	for i, v := range folders {
+		paths[i] = v.Path.Value
+	}
+	return paths
+}
+
+func filePaths(files []entity.FileInfo) []string {
+	paths := make([]string, len(files))
+	for i, v := range files {
+		paths[i] = v.Path.Value
+	}
+	return paths
+}
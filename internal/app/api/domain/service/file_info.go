@@ -4,12 +4,13 @@ import (
 	"errors"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
+	"strings"
 
 	"gorm.io/gorm"
 )
 
 type FileInfoService interface {
-	IsExists(*gorm.DB, *entity.FileInfo) (bool, error)
+	ResolveConflict(*gorm.DB, *entity.FileInfo, entity.ConflictMode) (*entity.FileInfo, error)
 }
 
 type fileInfoService struct {
@@ -22,13 +23,29 @@ func NewFileInfoService(fileInfoRepository repository.FileInfoRepository) FileIn
 	}
 }
 
-func (fs *fileInfoService) IsExists(db *gorm.DB, file *entity.FileInfo) (bool, error) {
-	if _, err := fs.fileInfoRepository.FindOneByPath(db, file.Path.Value); err != nil {
+func (fs *fileInfoService) ResolveConflict(db *gorm.DB, file *entity.FileInfo, conflict entity.ConflictMode) (*entity.FileInfo, error) {
+	name := file.Name.Value
+	dir := strings.TrimSuffix(file.Path.Value, name)
+	for n := 1; ; n++ {
+		existing, err := fs.fileInfoRepository.FindOneByPath(db, file.Path.Value)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, nil
-		} else {
-			return false, err
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch conflict {
+		case entity.ConflictOverwrite, entity.ConflictSkip:
+			return existing, nil
+		case entity.ConflictRename:
+			if err := file.SetName(entity.RenamedFileName(name, n)); err != nil {
+				return nil, err
+			}
+			if err := file.SetPath(dir + file.Name.Value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, entity.NewFileConflictError(existing)
 		}
 	}
-	return true, nil
 }
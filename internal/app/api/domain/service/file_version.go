@@ -0,0 +1,69 @@
+package service
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type FileVersionService interface {
+	Archive(*gorm.DB, *entity.FileInfo) (*entity.FileVersion, error)
+	Prune(*gorm.DB, uint64) error
+}
+
+type fileVersionService struct {
+	fileVersionRepository repository.FileVersionRepository
+	folderBodyRepository  repository.FolderBodyRepository
+	fileBodyRepository    repository.FileBodyRepository
+	limit                 int
+}
+
+func NewFileVersionService(fileVersionRepository repository.FileVersionRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository, limit int) FileVersionService {
+	return &fileVersionService{
+		fileVersionRepository: fileVersionRepository,
+		folderBodyRepository:  folderBodyRepository,
+		fileBodyRepository:    fileBodyRepository,
+		limit:                 limit,
+	}
+}
+
+func (fs *fileVersionService) Archive(db *gorm.DB, file *entity.FileInfo) (*entity.FileVersion, error) {
+	fileVersion, err := fs.fileVersionRepository.Create(db, entity.NewFileVersion(file))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fs.folderBodyRepository.Create(db, entity.NewFolderBody(entity.FileVersionDir(file.ID))); err != nil {
+		return nil, err
+	}
+	if err := fs.fileBodyRepository.Update(db, file.Path.Value, fileVersion.Path()); err != nil {
+		return nil, err
+	}
+
+	return fileVersion, nil
+}
+
+func (fs *fileVersionService) Prune(db *gorm.DB, fileID uint64) error {
+	if fs.limit <= 0 {
+		return nil
+	}
+
+	fileVersions, err := fs.fileVersionRepository.FindAllByFileID(db, fileID)
+	if err != nil {
+		return err
+	}
+	if len(fileVersions) <= fs.limit {
+		return nil
+	}
+
+	for _, v := range fileVersions[fs.limit:] {
+		if err := fs.fileBodyRepository.Remove(db, v.Path()); err != nil {
+			return err
+		}
+		if err := fs.fileVersionRepository.Remove(db, &v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
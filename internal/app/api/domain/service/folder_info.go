@@ -4,12 +4,13 @@ import (
 	"errors"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
+	"strings"
 
 	"gorm.io/gorm"
 )
 
 type FolderInfoService interface {
-	IsExists(*gorm.DB, *entity.FolderInfo) (bool, error)
+	ResolveConflict(*gorm.DB, *entity.FolderInfo, entity.ConflictMode) (*entity.FolderInfo, error)
 }
 
 type folderInfoService struct {
@@ -22,13 +23,29 @@ func NewFolderInfoService(folderInfoRepository repository.FolderInfoRepository)
 	}
 }
 
-func (fs *folderInfoService) IsExists(db *gorm.DB, folder *entity.FolderInfo) (bool, error) {
-	if _, err := fs.folderInfoRepository.FindOneByPath(db, folder.Path.Value); err != nil {
+func (fs *folderInfoService) ResolveConflict(db *gorm.DB, folder *entity.FolderInfo, conflict entity.ConflictMode) (*entity.FolderInfo, error) {
+	name := folder.Name.Value
+	dir := strings.TrimSuffix(folder.Path.Value, name+"/")
+	for n := 1; ; n++ {
+		existing, err := fs.folderInfoRepository.FindOneByPath(db, folder.Path.Value)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, nil
-		} else {
-			return false, err
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch conflict {
+		case entity.ConflictOverwrite, entity.ConflictSkip, entity.ConflictMerge:
+			return existing, nil
+		case entity.ConflictRename:
+			if err := folder.SetName(entity.RenamedFolderName(name, n)); err != nil {
+				return nil, err
+			}
+			if err := folder.Move(folder.Path.Value, dir+folder.Name.Value+"/"); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, entity.NewFolderConflictError(existing)
 		}
 	}
-	return true, nil
 }
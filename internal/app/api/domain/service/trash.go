@@ -0,0 +1,232 @@
+package service
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type TrashService interface {
+	TrashFolder(*gorm.DB, uint64, *entity.FolderInfo) (*entity.TrashItem, error)
+	TrashFile(*gorm.DB, uint64, *entity.FileInfo) (*entity.TrashItem, error)
+	Restore(*gorm.DB, *entity.TrashItem, *entity.FolderInfo, string) (string, error)
+	Purge(*gorm.DB, *entity.TrashItem) error
+}
+
+type trashService struct {
+	trashItemRepository  repository.TrashItemRepository
+	folderInfoRepository repository.FolderInfoRepository
+	fileInfoRepository   repository.FileInfoRepository
+	folderBodyRepository repository.FolderBodyRepository
+	fileBodyRepository   repository.FileBodyRepository
+}
+
+func NewTrashService(trashItemRepository repository.TrashItemRepository, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository) TrashService {
+	return &trashService{
+		trashItemRepository:  trashItemRepository,
+		folderInfoRepository: folderInfoRepository,
+		fileInfoRepository:   fileInfoRepository,
+		folderBodyRepository: folderBodyRepository,
+		fileBodyRepository:   fileBodyRepository,
+	}
+}
+
+func (ts *trashService) TrashFolder(db *gorm.DB, userID uint64, folder *entity.FolderInfo) (*entity.TrashItem, error) {
+	trashItem, err := ts.trashItemRepository.Create(db, entity.NewFolderTrashItem(userID, folder))
+	if err != nil {
+		return nil, err
+	}
+	if err := trashItem.CheckPathLength(folder.LongestPathLength()); err != nil {
+		return nil, err
+	}
+
+	if err := ts.folderBodyRepository.Create(db, entity.NewFolderBody(trashItem.Dir())); err != nil {
+		return nil, err
+	}
+
+	oldPath := folder.Path.Value
+	path := trashItem.TrashPath()
+	if err := ts.folderBodyRepository.Update(db, oldPath, path); err != nil {
+		return nil, err
+	}
+
+	if err := folder.Move(oldPath, path); err != nil {
+		return nil, err
+	}
+	folder.ParentFolderID = nil
+
+	if _, err := ts.folderInfoRepository.Update(db, folder); err != nil {
+		return nil, err
+	}
+	if err := ts.fileInfoRepository.TrashByPathPrefix(db, trashItem.Dir()); err != nil {
+		return nil, err
+	}
+	if err := ts.folderInfoRepository.TrashByPathPrefix(db, trashItem.Dir()); err != nil {
+		return nil, err
+	}
+
+	return trashItem, nil
+}
+
+func (ts *trashService) TrashFile(db *gorm.DB, userID uint64, file *entity.FileInfo) (*entity.TrashItem, error) {
+	trashItem, err := ts.trashItemRepository.Create(db, entity.NewFileTrashItem(userID, file))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ts.folderBodyRepository.Create(db, entity.NewFolderBody(trashItem.Dir())); err != nil {
+		return nil, err
+	}
+
+	oldPath := file.Path.Value
+	path := trashItem.TrashPath()
+	if err := ts.fileBodyRepository.Update(db, oldPath, path); err != nil {
+		return nil, err
+	}
+
+	if err := file.Move(oldPath, path); err != nil {
+		return nil, err
+	}
+
+	if _, err := ts.fileInfoRepository.Update(db, file); err != nil {
+		return nil, err
+	}
+	if err := ts.fileInfoRepository.TrashByPathPrefix(db, trashItem.Dir()); err != nil {
+		return nil, err
+	}
+
+	return trashItem, nil
+}
+
+func (ts *trashService) Restore(db *gorm.DB, trashItem *entity.TrashItem, parent *entity.FolderInfo, name string) (string, error) {
+	if err := ts.fileInfoRepository.RestoreByPathPrefix(db, trashItem.Dir()); err != nil {
+		return "", err
+	}
+
+	var path string
+	if trashItem.IsFolder() {
+		if err := ts.folderInfoRepository.RestoreByPathPrefix(db, trashItem.Dir()); err != nil {
+			return "", err
+		}
+
+		folder, err := ts.folderInfoRepository.FindOneByIDWithLower(db, *trashItem.FolderID)
+		if err != nil {
+			return "", err
+		}
+
+		oldPath := folder.Path.Value
+		path = parent.Path.Value + name + "/"
+		if err := ts.folderBodyRepository.Update(db, oldPath, path); err != nil {
+			return "", err
+		}
+
+		if err := folder.SetName(name); err != nil {
+			return "", err
+		}
+		if err := folder.Move(oldPath, path); err != nil {
+			return "", err
+		}
+		folder.ParentFolderID = &parent.ID
+
+		if _, err := ts.folderInfoRepository.Update(db, folder); err != nil {
+			return "", err
+		}
+	} else {
+		file, err := ts.fileInfoRepository.FindOneByID(db, *trashItem.FileID)
+		if err != nil {
+			return "", err
+		}
+
+		oldPath := file.Path.Value
+		path = parent.Path.Value + name
+		if err := ts.fileBodyRepository.Update(db, oldPath, path); err != nil {
+			return "", err
+		}
+
+		if err := file.SetName(name); err != nil {
+			return "", err
+		}
+		if err := file.Move(oldPath, path); err != nil {
+			return "", err
+		}
+		file.FolderID = parent.ID
+
+		if _, err := ts.fileInfoRepository.Update(db, file); err != nil {
+			return "", err
+		}
+	}
+
+	if err := ts.folderBodyRepository.Remove(db, trashItem.Dir()); err != nil {
+		return "", err
+	}
+
+	return path, ts.trashItemRepository.Remove(db, trashItem)
+}
+
+func (ts *trashService) Purge(db *gorm.DB, trashItem *entity.TrashItem) error {
+	if trashItem.IsFolder() {
+		folder, err := ts.folderInfoRepository.FindOneByIDWithLower(db.Unscoped(), *trashItem.FolderID)
+		if err != nil {
+			return err
+		}
+
+		trashItems, err := ts.trashItemRepository.FindAllByParentFolderIDs(db, ts.folderIDs(folder))
+		if err != nil {
+			return err
+		}
+		for _, v := range trashItems {
+			if err := ts.Purge(db, &v); err != nil {
+				return err
+			}
+		}
+
+		if err := ts.folderBodyRepository.Remove(db, trashItem.Dir()); err != nil {
+			return err
+		}
+		for _, v := range ts.fileIDs(folder) {
+			if err := ts.folderBodyRepository.Remove(db, entity.FileVersionDir(v)); err != nil {
+				return err
+			}
+		}
+		if err := ts.folderInfoRepository.Remove(db, folder); err != nil {
+			return err
+		}
+	} else {
+		file, err := ts.fileInfoRepository.FindOneByID(db.Unscoped(), *trashItem.FileID)
+		if err != nil {
+			return err
+		}
+
+		if err := ts.folderBodyRepository.Remove(db, trashItem.Dir()); err != nil {
+			return err
+		}
+		if err := ts.folderBodyRepository.Remove(db, entity.FileVersionDir(file.ID)); err != nil {
+			return err
+		}
+		if err := ts.fileInfoRepository.Remove(db, file); err != nil {
+			return err
+		}
+	}
+
+	return ts.trashItemRepository.Remove(db, trashItem)
+}
+
+func (ts *trashService) folderIDs(folder *entity.FolderInfo) []uint64 {
+	ids := []uint64{folder.ID}
+	for _, v := range folder.Folders {
+		ids = append(ids, ts.folderIDs(&v)...)
+	}
+	return ids
+}
+
+func (ts *trashService) fileIDs(folder *entity.FolderInfo) []uint64 {
+	var ids []uint64
+	for _, v := range folder.Files {
+		ids = append(ids, v.ID)
+	}
+	for _, v := range folder.Folders {
+		ids = append(ids, ts.fileIDs(&v)...)
+	}
+	return ids
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestTrashFolderPathTooLong(t *testing.T) {
+	parentFolderID := uint64(1)
+	folder, err := entity.NewFolderInfo(&parentFolderID, "folder", "/folder/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folder.ID = 2
+	name := strings.Repeat("a", 128)
+	inner, err := entity.NewFolderInfo(&folder.ID, name, "/folder/"+name+"/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file, err := entity.NewFileInfo(3, name, inner.Path.Value+strings.Repeat("b", 118), "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	inner.Files = []entity.FileInfo{*file}
+	folder.Folders = []entity.FolderInfo{*inner}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, trashItem *entity.TrashItem) (*entity.TrashItem, error) {
+		trashItem.ID = 1
+		return trashItem, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	ts := NewTrashService(trashItemRepository, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository)
+
+	if _, err := ts.TrashFolder(nil, 1, folder); !errors.Is(err, entity.ErrTrashPathTooLong) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if folder.Path.Value != "/folder/" {
+		t.Error("folder is moved")
+	}
+}
+
+func TestTrashFile(t *testing.T) {
+	file, err := entity.NewFileInfo(2, "name.txt", "/folder/name.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file.ID = 3
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, trashItem *entity.TrashItem) (*entity.TrashItem, error) {
+		trashItem.ID = 1
+		return trashItem, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		return file, nil
+	})
+	fileInfoRepository.EXPECT().TrashByPathPrefix(gomock.Any(), "/:trash/1/").Return(nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().Create(gomock.Any(), entity.NewFolderBody("/:trash/1/")).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Update(gomock.Any(), "/folder/name.txt", "/:trash/1/name.txt").Return(nil)
+
+	ts := NewTrashService(trashItemRepository, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository)
+
+	if _, err := ts.TrashFile(nil, 1, file); err != nil {
+		t.Error(err.Error())
+	}
+	if file.Path.Value != "/:trash/1/name.txt" {
+		t.Error("failed to move file to the trash")
+	}
+}
+
+func TestPurgeFolderWithTrashedFolder(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folder, err := entity.NewFolderInfo(nil, "folder", "/:trash/1/folder/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folder.ID = 2
+	inner, err := entity.NewFolderInfo(nil, "inner", "/:trash/3/inner/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	inner.ID = 4
+
+	trashItem := &entity.TrashItem{ID: 1, FolderID: &folder.ID}
+	innerTrashItem := &entity.TrashItem{ID: 3, FolderID: &inner.ID, ParentFolderID: &folder.ID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().FindAllByParentFolderIDs(gomock.Any(), []uint64{folder.ID}).Return([]entity.TrashItem{*innerTrashItem}, nil)
+	trashItemRepository.EXPECT().FindAllByParentFolderIDs(gomock.Any(), []uint64{inner.ID}).Return(nil, nil)
+	trashItemRepository.EXPECT().Remove(gomock.Any(), innerTrashItem).Return(nil)
+	trashItemRepository.EXPECT().Remove(gomock.Any(), trashItem).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), folder.ID).Return(folder, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), inner.ID).Return(inner, nil)
+	folderInfoRepository.EXPECT().Remove(gomock.Any(), inner).Return(nil)
+	folderInfoRepository.EXPECT().Remove(gomock.Any(), folder).Return(nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().Remove(gomock.Any(), "/:trash/3/").Return(nil)
+	folderBodyRepository.EXPECT().Remove(gomock.Any(), "/:trash/1/").Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	ts := NewTrashService(trashItemRepository, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository)
+
+	if err := ts.Purge(db, trashItem); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"io"
+	"io/fs"
+
+	"gorm.io/gorm"
+)
+
+type UnitOfWork interface {
+	Transaction(*gorm.DB, func(*gorm.DB) error) error
+	Recover(*gorm.DB) error
+}
+
+type unitOfWork struct {
+	journalRepository    repository.JournalRepository
+	folderBodyRepository repository.FolderBodyRepository
+	fileBodyRepository   repository.FileBodyRepository
+}
+
+func NewUnitOfWork(journalRepository repository.JournalRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository) UnitOfWork {
+	return &unitOfWork{
+		journalRepository:    journalRepository,
+		folderBodyRepository: folderBodyRepository,
+		fileBodyRepository:   fileBodyRepository,
+	}
+}
+
+func (uw *unitOfWork) Transaction(db *gorm.DB, fn func(*gorm.DB) error) error {
+	if journalFrom(db) != nil {
+		return db.Transaction(fn)
+	}
+
+	jc := &journalContext{db: db, journal: entity.NewJournal()}
+	if err := db.WithContext(context.WithValue(db.Statement.Context, journalKey{}, jc)).Transaction(func(tx *gorm.DB) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if jc.journal.ID == 0 {
+			return nil
+		}
+		jc.journal.Commit()
+		_, err := uw.journalRepository.Update(tx, jc.journal)
+		return err
+	}); err != nil {
+		return errors.Join(err, uw.rollback(db, jc.journal))
+	}
+
+	return uw.commit(db, jc.journal)
+}
+
+func (uw *unitOfWork) Recover(db *gorm.DB) error {
+	journals, err := uw.journalRepository.FindAll(db)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range journals {
+		if v.IsCommitted() {
+			errs = append(errs, uw.commit(db, &v))
+		} else {
+			errs = append(errs, uw.rollback(db, &v))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (uw *unitOfWork) commit(db *gorm.DB, journal *entity.Journal) error {
+	if journal.ID == 0 {
+		return nil
+	}
+	for _, v := range journal.Operations {
+		if !v.IsDeferred() {
+			continue
+		}
+		if err := ignoreNotExist(uw.apply(db, &v)); err != nil {
+			return err
+		}
+	}
+	return uw.journalRepository.Remove(db, journal)
+}
+
+func (uw *unitOfWork) rollback(db *gorm.DB, journal *entity.Journal) error {
+	if journal.ID == 0 {
+		return nil
+	}
+	var errs []error
+	for i := len(journal.Operations) - 1; 0 <= i; i-- {
+		if err := ignoreNotExist(uw.compensate(db, &journal.Operations[i])); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+	return uw.journalRepository.Remove(db, journal)
+}
+
+func (uw *unitOfWork) apply(db *gorm.DB, operation *entity.JournalOperation) error {
+	switch operation.Kind {
+	case entity.JournalRemoveFolder:
+		return uw.folderBodyRepository.Remove(db, operation.Path)
+	case entity.JournalRemoveFile:
+		return uw.fileBodyRepository.Remove(db, operation.Path)
+	}
+	return nil
+}
+
+func (uw *unitOfWork) compensate(db *gorm.DB, operation *entity.JournalOperation) error {
+	switch operation.Kind {
+	case entity.JournalCreateFolder:
+		return uw.folderBodyRepository.Remove(db, operation.Path)
+	case entity.JournalMoveFolder:
+		return uw.folderBodyRepository.Update(db, operation.NewPath, operation.Path)
+	case entity.JournalCreateFile:
+		return uw.fileBodyRepository.Remove(db, operation.Path)
+	case entity.JournalMoveFile:
+		return uw.fileBodyRepository.Update(db, operation.NewPath, operation.Path)
+	case entity.JournalCopyFile:
+		return uw.fileBodyRepository.Remove(db, operation.NewPath)
+	}
+	return nil
+}
+
+type journalKey struct{}
+
+type journalContext struct {
+	db      *gorm.DB
+	journal *entity.Journal
+}
+
+func journalFrom(db *gorm.DB) *journalContext {
+	if db.Statement.Context == nil {
+		return nil
+	}
+	jc, _ := db.Statement.Context.Value(journalKey{}).(*journalContext)
+	return jc
+}
+
+func (jc *journalContext) save(journalRepository repository.JournalRepository) error {
+	if jc.journal.ID != 0 {
+		_, err := journalRepository.Update(jc.db, jc.journal)
+		return err
+	}
+
+	journal, err := journalRepository.Create(jc.db, jc.journal)
+	if err != nil {
+		return err
+	}
+	*jc.journal = *journal
+	return nil
+}
+
+func stage(db *gorm.DB, journalRepository repository.JournalRepository, operation entity.JournalOperation, apply func() error) error {
+	jc := journalFrom(db)
+	if jc == nil {
+		return apply()
+	}
+
+	jc.journal.Stage(operation)
+	if err := jc.save(journalRepository); err != nil {
+		jc.journal.Unstage()
+		return err
+	}
+	if operation.IsDeferred() {
+		return nil
+	}
+
+	if err := apply(); err != nil {
+		jc.journal.Unstage()
+		return errors.Join(err, jc.save(journalRepository))
+	}
+	return nil
+}
+
+func ignoreNotExist(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+type journalFolderBodyRepository struct {
+	journalRepository    repository.JournalRepository
+	folderBodyRepository repository.FolderBodyRepository
+}
+
+func NewJournalFolderBodyRepository(journalRepository repository.JournalRepository, folderBodyRepository repository.FolderBodyRepository) repository.FolderBodyRepository {
+	return &journalFolderBodyRepository{
+		journalRepository:    journalRepository,
+		folderBodyRepository: folderBodyRepository,
+	}
+}
+
+func (jr *journalFolderBodyRepository) Create(db *gorm.DB, folder *entity.FolderBody) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalCreateFolder, Path: folder.Path}, func() error {
+		return jr.folderBodyRepository.Create(db, folder)
+	})
+}
+
+func (jr *journalFolderBodyRepository) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalMoveFolder, Path: oldPath, NewPath: newPath}, func() error {
+		return jr.folderBodyRepository.Update(db, oldPath, newPath)
+	})
+}
+
+func (jr *journalFolderBodyRepository) Remove(db *gorm.DB, path string) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalRemoveFolder, Path: path}, func() error {
+		return jr.folderBodyRepository.Remove(db, path)
+	})
+}
+
+func (jr *journalFolderBodyRepository) FindAll() ([]string, error) {
+	return jr.folderBodyRepository.FindAll()
+}
+
+type journalFileBodyRepository struct {
+	journalRepository  repository.JournalRepository
+	fileBodyRepository repository.FileBodyRepository
+}
+
+func NewJournalFileBodyRepository(journalRepository repository.JournalRepository, fileBodyRepository repository.FileBodyRepository) repository.FileBodyRepository {
+	return &journalFileBodyRepository{
+		journalRepository:  journalRepository,
+		fileBodyRepository: fileBodyRepository,
+	}
+}
+
+func (jr *journalFileBodyRepository) Create(db *gorm.DB, file *entity.FileBody) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalCreateFile, Path: file.Path}, func() error {
+		return jr.fileBodyRepository.Create(db, file)
+	})
+}
+
+func (jr *journalFileBodyRepository) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalMoveFile, Path: oldPath, NewPath: newPath}, func() error {
+		return jr.fileBodyRepository.Update(db, oldPath, newPath)
+	})
+}
+
+func (jr *journalFileBodyRepository) Remove(db *gorm.DB, path string) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalRemoveFile, Path: path}, func() error {
+		return jr.fileBodyRepository.Remove(db, path)
+	})
+}
+
+func (jr *journalFileBodyRepository) Copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	return stage(db, jr.journalRepository, entity.JournalOperation{Kind: entity.JournalCopyFile, Path: sourcePath, NewPath: targetPath}, func() error {
+		return jr.fileBodyRepository.Copy(db, sourcePath, targetPath)
+	})
+}
+
+func (jr *journalFileBodyRepository) Read(path string) (io.ReadSeekCloser, error) {
+	return jr.fileBodyRepository.Read(path)
+}
+
+func (jr *journalFileBodyRepository) FindAll() ([]string, error) {
+	return jr.fileBodyRepository.FindAll()
+}
+
+func (jr *journalFileBodyRepository) PresignedURL(path string, mimeType string) (string, error) {
+	return jr.fileBodyRepository.PresignedURL(path, mimeType)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestUnitOfWorkRollback(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	journalRepository := mock_repository.NewMockJournalRepository(ctrl)
+	journalRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+		created := *journal
+		created.ID = 1
+		return &created, nil
+	})
+	journalRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil)
+	journalRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	gomock.InOrder(
+		fileBodyRepository.EXPECT().Update(gomock.Any(), "/old", "/new").Return(nil),
+		fileBodyRepository.EXPECT().Copy(gomock.Any(), "/new", "/copy").Return(nil),
+		fileBodyRepository.EXPECT().Remove(gomock.Any(), "/copy").Return(nil),
+		fileBodyRepository.EXPECT().Update(gomock.Any(), "/new", "/old").Return(nil),
+	)
+
+	uw := NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+	journaled := NewJournalFileBodyRepository(journalRepository, fileBodyRepository)
+
+	failure := errors.New("failure")
+	err = uw.Transaction(db, func(tx *gorm.DB) error {
+		if err := journaled.Update(tx, "/old", "/new"); err != nil {
+			return err
+		}
+		if err := journaled.Copy(tx, "/new", "/copy"); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Error("failed to roll back unit of work")
+	}
+}
+
+func TestUnitOfWorkRollbackFolderCreate(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	journalRepository := mock_repository.NewMockJournalRepository(ctrl)
+	journalRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+		created := *journal
+		created.ID = 1
+		return &created, nil
+	})
+	journalRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil)
+	journalRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	gomock.InOrder(
+		folderBodyRepository.EXPECT().Create(gomock.Any(), entity.NewFolderBody("/folder/")).Return(nil),
+		fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+		fileBodyRepository.EXPECT().Remove(gomock.Any(), "/folder/name").Return(nil),
+		folderBodyRepository.EXPECT().Remove(gomock.Any(), "/folder/").Return(nil),
+	)
+
+	uw := NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+	journaledFolder := NewJournalFolderBodyRepository(journalRepository, folderBodyRepository)
+	journaledFile := NewJournalFileBodyRepository(journalRepository, fileBodyRepository)
+
+	failure := errors.New("failure")
+	err = uw.Transaction(db, func(tx *gorm.DB) error {
+		if err := journaledFolder.Create(tx, entity.NewFolderBody("/folder/")); err != nil {
+			return err
+		}
+		if err := journaledFile.Create(tx, entity.NewFileBody("/folder/name", strings.NewReader("file"))); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Error("failed to roll back unit of work")
+	}
+}
+
+func TestUnitOfWorkCommit(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	committed := false
+	journalRepository := mock_repository.NewMockJournalRepository(ctrl)
+	journalRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+		created := *journal
+		created.ID = 1
+		return &created, nil
+	})
+	journalRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+		committed = journal.IsCommitted()
+		return journal, nil
+	})
+	journalRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().Remove(gomock.Any(), "/path/").DoAndReturn(func(db *gorm.DB, path string) error {
+		if !committed {
+			t.Error("failed to defer folder removal")
+		}
+		return nil
+	})
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	uw := NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+	journaled := NewJournalFolderBodyRepository(journalRepository, folderBodyRepository)
+
+	if err := uw.Transaction(db, func(tx *gorm.DB) error {
+		return journaled.Remove(tx, "/path/")
+	}); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestUnitOfWorkRecover(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	journals := []entity.Journal{
+		{ID: 1, Status: entity.JournalStatusPending, Operations: []entity.JournalOperation{{Kind: entity.JournalCreateFolder, Path: "/folder/"}, {Kind: entity.JournalMoveFolder, Path: "/old/", NewPath: "/new/"}}},
+		{ID: 2, Status: entity.JournalStatusCommitted, Operations: []entity.JournalOperation{{Kind: entity.JournalCreateFile, Path: "/file"}, {Kind: entity.JournalRemoveFile, Path: "/removed"}}},
+	}
+
+	journalRepository := mock_repository.NewMockJournalRepository(ctrl)
+	journalRepository.EXPECT().FindAll(gomock.Any()).Return(journals, nil)
+	journalRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	gomock.InOrder(
+		folderBodyRepository.EXPECT().Update(gomock.Any(), "/new/", "/old/").Return(nil),
+		folderBodyRepository.EXPECT().Remove(gomock.Any(), "/folder/").Return(nil),
+	)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Remove(gomock.Any(), "/removed").Return(nil)
+
+	uw := NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+
+	if err := uw.Recover(db); err != nil {
+		t.Error(err.Error())
+	}
+}
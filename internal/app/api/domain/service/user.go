@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type UserService interface {
+	IsExists(*gorm.DB, *entity.User) (bool, error)
+}
+
+type userService struct {
+	userRepository repository.UserRepository
+}
+
+func NewUserService(userRepository repository.UserRepository) UserService {
+	return &userService{
+		userRepository: userRepository,
+	}
+}
+
+func (us *userService) IsExists(db *gorm.DB, user *entity.User) (bool, error) {
+	if _, err := us.userRepository.FindOneByName(db, user.Name.Value); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+	return true, nil
+}
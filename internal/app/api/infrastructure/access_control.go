@@ -0,0 +1,96 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type accessControlInfrastructure struct{}
+
+func NewAccessControlInfrastructure() repository.AccessControlRepository {
+	return &accessControlInfrastructure{}
+}
+
+func (ai *accessControlInfrastructure) Create(db *gorm.DB, entry *entity.AccessControlEntry) (*entity.AccessControlEntry, error) {
+	entryModel := ai.convertToModel(entry)
+	if err := db.Create(entryModel).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(entryModel)
+}
+
+func (ai *accessControlInfrastructure) Update(db *gorm.DB, entry *entity.AccessControlEntry) (*entity.AccessControlEntry, error) {
+	entryModel := ai.convertToModel(entry)
+	if err := db.Save(entryModel).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(entryModel)
+}
+
+func (ai *accessControlInfrastructure) Remove(db *gorm.DB, entry *entity.AccessControlEntry) error {
+	entryModel := ai.convertToModel(entry)
+	return db.Delete(entryModel).Error
+}
+
+func (ai *accessControlInfrastructure) FindAllByFolderID(db *gorm.DB, folderID uint64) ([]entity.AccessControlEntry, error) {
+	var entryModels []model.AccessControlModel
+	if err := db.Order("id").Find(&entryModels, "folder_id = ?", folderID).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntities(entryModels)
+}
+
+func (ai *accessControlInfrastructure) FindAllByUserID(db *gorm.DB, userID uint64) ([]entity.AccessControlEntry, error) {
+	var entryModels []model.AccessControlModel
+	if err := db.Order("id").Find(&entryModels, "user_id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntities(entryModels)
+}
+
+func (ai *accessControlInfrastructure) FindOneByFolderIDAndUserID(db *gorm.DB, folderID uint64, userID uint64) (*entity.AccessControlEntry, error) {
+	var entryModel model.AccessControlModel
+	if err := db.First(&entryModel, "folder_id = ? and user_id = ?", folderID, userID).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(&entryModel)
+}
+
+func (ai *accessControlInfrastructure) convertToModel(entry *entity.AccessControlEntry) *model.AccessControlModel {
+	return &model.AccessControlModel{
+		ID:         entry.ID,
+		FolderID:   entry.FolderID,
+		UserID:     entry.UserID,
+		Permission: entry.Permission.String(),
+		CreatedAt:  entry.CreatedAt,
+		UpdatedAt:  entry.UpdatedAt,
+	}
+}
+
+func (ai *accessControlInfrastructure) convertToEntity(entry *model.AccessControlModel) (*entity.AccessControlEntry, error) {
+	entryEntity := &entity.AccessControlEntry{}
+	entryEntity.ID = entry.ID
+	entryEntity.FolderID = entry.FolderID
+	entryEntity.UserID = entry.UserID
+	if err := entryEntity.SetPermission(entry.Permission); err != nil {
+		return nil, err
+	}
+	entryEntity.CreatedAt = entry.CreatedAt
+	entryEntity.UpdatedAt = entry.UpdatedAt
+	return entryEntity, nil
+}
+
+func (ai *accessControlInfrastructure) convertToEntities(entries []model.AccessControlModel) ([]entity.AccessControlEntry, error) {
+	entryEntities := make([]entity.AccessControlEntry, len(entries))
+	for i, v := range entries {
+		entry, err := ai.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		entryEntities[i] = *entry
+	}
+	return entryEntities, nil
+}
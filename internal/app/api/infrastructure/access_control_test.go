@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateAccessControl(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	entry, err := entity.NewAccessControlEntry(1, 2, "write")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `access_control_entries` (`folder_id`,`user_id`,`permission`,`created_at`,`updated_at`) VALUES (?,?,?,?,?)")).WithArgs(1, 2, "write", database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ai := NewAccessControlInfrastructure()
+
+	result, err := ai.Create(db, entry)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Permission != entity.PermissionWrite {
+		t.Error("failed to create access control entry")
+	}
+}
+
+func TestRemoveAccessControl(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	entry, err := entity.NewAccessControlEntry(1, 2, "read")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	entry.ID = 1
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `access_control_entries` WHERE `access_control_entries`.`id` = ?")).WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ai := NewAccessControlInfrastructure()
+
+	if err := ai.Remove(db, entry); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestFindAllAccessControlByUserID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `access_control_entries` WHERE user_id = ? ORDER BY id")).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "user_id", "permission", "created_at", "updated_at"}).AddRow(1, 1, 2, "read", time.Now(), time.Now()).AddRow(2, 3, 2, "admin", time.Now(), time.Now()))
+
+	ai := NewAccessControlInfrastructure()
+
+	result, err := ai.FindAllByUserID(db, 2)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[0].Permission != entity.PermissionRead || result[1].Permission != entity.PermissionAdmin {
+		t.Error("failed to find access control entries by user id")
+	}
+}
+
+func TestFindOneAccessControlByFolderIDAndUserID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `access_control_entries` WHERE folder_id = ? and user_id = ? ORDER BY `access_control_entries`.`id` LIMIT ?")).WithArgs(1, 2, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "user_id", "permission", "created_at", "updated_at"}).AddRow(1, 1, 2, "share", time.Now(), time.Now()))
+
+	ai := NewAccessControlInfrastructure()
+
+	result, err := ai.FindOneByFolderIDAndUserID(db, 1, 2)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.Permission != entity.PermissionShare {
+		t.Error("failed to find access control entry")
+	}
+}
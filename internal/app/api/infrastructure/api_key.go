@@ -0,0 +1,105 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type apiKeyInfrastructure struct{}
+
+func NewAPIKeyInfrastructure() repository.APIKeyRepository {
+	return &apiKeyInfrastructure{}
+}
+
+func (ai *apiKeyInfrastructure) Create(db *gorm.DB, apiKey *entity.APIKey) (*entity.APIKey, error) {
+	apiKeyModel := ai.convertToModel(apiKey)
+	if err := db.Create(apiKeyModel).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(apiKeyModel)
+}
+
+func (ai *apiKeyInfrastructure) Update(db *gorm.DB, apiKey *entity.APIKey) (*entity.APIKey, error) {
+	apiKeyModel := ai.convertToModel(apiKey)
+	if err := db.Save(apiKeyModel).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(apiKeyModel)
+}
+
+func (ai *apiKeyInfrastructure) Remove(db *gorm.DB, apiKey *entity.APIKey) error {
+	apiKeyModel := ai.convertToModel(apiKey)
+	return db.Delete(apiKeyModel).Error
+}
+
+func (ai *apiKeyInfrastructure) FindAllByUserID(db *gorm.DB, userID uint64) ([]entity.APIKey, error) {
+	var apiKeyModels []model.APIKeyModel
+	if err := db.Order("id").Find(&apiKeyModels, "user_id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]entity.APIKey, len(apiKeyModels))
+	for i, v := range apiKeyModels {
+		apiKey, err := ai.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys[i] = *apiKey
+	}
+	return apiKeys, nil
+}
+
+func (ai *apiKeyInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.APIKey, error) {
+	var apiKeyModel model.APIKeyModel
+	if err := db.First(&apiKeyModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(&apiKeyModel)
+}
+
+func (ai *apiKeyInfrastructure) FindOneByPrefix(db *gorm.DB, prefix string) (*entity.APIKey, error) {
+	var apiKeyModel model.APIKeyModel
+	if err := db.First(&apiKeyModel, "prefix = ?", prefix).Error; err != nil {
+		return nil, err
+	}
+	return ai.convertToEntity(&apiKeyModel)
+}
+
+func (ai *apiKeyInfrastructure) convertToModel(apiKey *entity.APIKey) *model.APIKeyModel {
+	return &model.APIKeyModel{
+		ID:         apiKey.ID,
+		UserID:     apiKey.UserID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Hash:       apiKey.Hash,
+		Scope:      apiKey.Scope.String(),
+		FolderID:   apiKey.FolderID,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+		UpdatedAt:  apiKey.UpdatedAt,
+	}
+}
+
+func (ai *apiKeyInfrastructure) convertToEntity(apiKey *model.APIKeyModel) (*entity.APIKey, error) {
+	apiKeyEntity := &entity.APIKey{}
+	apiKeyEntity.ID = apiKey.ID
+	apiKeyEntity.UserID = apiKey.UserID
+	if err := apiKeyEntity.SetName(apiKey.Name); err != nil {
+		return nil, err
+	}
+	apiKeyEntity.Prefix = apiKey.Prefix
+	apiKeyEntity.Hash = apiKey.Hash
+	if err := apiKeyEntity.SetScope(apiKey.Scope); err != nil {
+		return nil, err
+	}
+	apiKeyEntity.FolderID = apiKey.FolderID
+	apiKeyEntity.ExpiresAt = apiKey.ExpiresAt
+	apiKeyEntity.LastUsedAt = apiKey.LastUsedAt
+	apiKeyEntity.CreatedAt = apiKey.CreatedAt
+	apiKeyEntity.UpdatedAt = apiKey.UpdatedAt
+	return apiKeyEntity, nil
+}
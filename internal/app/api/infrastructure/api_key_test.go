@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderID := uint64(1)
+	apiKey, _, err := entity.NewAPIKey(1, "backup", "read", &folderID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `api_keys` (`user_id`,`name`,`prefix`,`hash`,`scope`,`folder_id`,`expires_at`,`last_used_at`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")).WithArgs(1, "backup", apiKey.Prefix, apiKey.Hash, "read", 1, database.AnyTime{}, nil, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ai := NewAPIKeyInfrastructure()
+
+	result, err := ai.Create(db, apiKey)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Scope != entity.PermissionRead {
+		t.Error("failed to create api key")
+	}
+}
+
+func TestFindAllAPIKeyByUserID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE user_id = ? ORDER BY id")).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "prefix", "hash", "scope", "folder_id", "expires_at", "last_used_at", "created_at", "updated_at"}).AddRow(1, 1, "backup", "prefix", "hash", "read", nil, time.Now(), nil, time.Now(), time.Now()).AddRow(2, 1, "ci", "prefix2", "hash", "write", 1, time.Now(), time.Now(), time.Now(), time.Now()))
+
+	ai := NewAPIKeyInfrastructure()
+
+	result, err := ai.FindAllByUserID(db, 1)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[1].Scope != entity.PermissionWrite || *result[1].FolderID != 1 {
+		t.Error("failed to find api keys")
+	}
+}
+
+func TestFindOneAPIKeyByPrefix(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE prefix = ? ORDER BY `api_keys`.`id` LIMIT ?")).WithArgs("prefix", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "prefix", "hash", "scope", "folder_id", "expires_at", "last_used_at", "created_at", "updated_at"}).AddRow(1, 1, "backup", "prefix", "hash", "read", nil, time.Now(), nil, time.Now(), time.Now()))
+
+	ai := NewAPIKeyInfrastructure()
+
+	result, err := ai.FindOneByPrefix(db, "prefix")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Prefix != "prefix" {
+		t.Error("failed to find api key")
+	}
+}
@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/types"
+	"io"
+
+	"gorm.io/gorm"
+)
+
+type blobFileBodyInfrastructure struct {
+	storage *blobStorage
+}
+
+func NewBlobFileBodyInfrastructure(db *gorm.DB, storage types.Storage) repository.FileBodyRepository {
+	return &blobFileBodyInfrastructure{
+		storage: &blobStorage{db: db, storage: storage},
+	}
+}
+
+func (bi *blobFileBodyInfrastructure) Create(db *gorm.DB, file *entity.FileBody) error {
+	return storageError(bi.storage.create(db, file.Path, file.Body))
+}
+
+func (bi *blobFileBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(bi.storage.move(db, oldPath, newPath))
+}
+
+func (bi *blobFileBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(bi.storage.remove(db, path))
+}
+
+func (bi *blobFileBodyInfrastructure) Copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	return storageError(bi.storage.copy(db, sourcePath, targetPath))
+}
+
+func (bi *blobFileBodyInfrastructure) Read(path string) (io.ReadSeekCloser, error) {
+	f, err := bi.storage.read(path)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return f, nil
+}
+
+func (bi *blobFileBodyInfrastructure) FindAll() ([]string, error) {
+	paths, err := bi.storage.list()
+	return paths, storageError(err)
+}
+
+func (bi *blobFileBodyInfrastructure) PresignedURL(path string, mimeType string) (string, error) {
+	return "", nil
+}
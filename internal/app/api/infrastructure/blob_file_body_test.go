@@ -0,0 +1,111 @@
+package infrastructure
+
+import (
+	"errors"
+	"file-server/internal/pkg/types"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+func TestUpdateBlobFileBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `blob_references` SET `path`=?,`updated_at`=? WHERE path = ?")).WithArgs("/new", database.AnyTime{}, "/old").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	bi := NewBlobFileBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	if err := bi.Update(db, "/old", "/new"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRemoveBlobFileBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `blob_references` WHERE path = ?")).WithArgs("/path").WillReturnRows(sqlmock.NewRows([]string{"path", "hash", "created_at", "updated_at"}).AddRow("/path", "hash", time.Now(), time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `blob_references` WHERE path = ?")).WithArgs("/path").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `blobs` WHERE hash = ? ORDER BY `blobs`.`hash` LIMIT ? FOR UPDATE")).WithArgs("hash", 1).WillReturnRows(sqlmock.NewRows([]string{"hash", "size", "reference_count", "created_at", "updated_at"}).AddRow("hash", 4, 2, time.Now(), time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `blobs` SET `size`=?,`reference_count`=?,`created_at`=?,`updated_at`=? WHERE `hash` = ?")).WithArgs(4, 1, database.AnyTime{}, database.AnyTime{}, "hash").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	bi := NewBlobFileBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	if err := bi.Remove(db, "/path"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestCopyBlobFileBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `blob_references` WHERE path = ? ORDER BY `blob_references`.`path` LIMIT ?")).WithArgs("/source", 1).WillReturnRows(sqlmock.NewRows([]string{"path", "hash", "created_at", "updated_at"}).AddRow("/source", "hash", time.Now(), time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `blobs` SET `reference_count`=reference_count + 1,`updated_at`=? WHERE hash = ?")).WithArgs(database.AnyTime{}, "hash").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `blob_references` (`path`,`hash`,`created_at`,`updated_at`) VALUES (?,?,?,?)")).WithArgs("/target", "hash", database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	bi := NewBlobFileBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	if err := bi.Copy(db, "/source", "/target"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestCopyBlobFileBodyWithRollback(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `blob_references` WHERE path = ? ORDER BY `blob_references`.`path` LIMIT ?")).WithArgs("/source", 1).WillReturnRows(sqlmock.NewRows([]string{"path", "hash", "created_at", "updated_at"}).AddRow("/source", "hash", time.Now(), time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `blobs` SET `reference_count`=reference_count + 1,`updated_at`=? WHERE hash = ?")).WithArgs(database.AnyTime{}, "hash").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `blob_references` (`path`,`hash`,`created_at`,`updated_at`) VALUES (?,?,?,?)")).WithArgs("/target", "hash", database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	bi := NewBlobFileBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	rollback := errors.New("rollback")
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		if err := bi.Copy(tx, "/source", "/target"); err != nil {
+			return err
+		}
+		return rollback
+	}); !errors.Is(err, rollback) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/types"
+
+	"gorm.io/gorm"
+)
+
+type blobFolderBodyInfrastructure struct {
+	storage *blobStorage
+}
+
+func NewBlobFolderBodyInfrastructure(db *gorm.DB, storage types.Storage) repository.FolderBodyRepository {
+	return &blobFolderBodyInfrastructure{
+		storage: &blobStorage{db: db, storage: storage},
+	}
+}
+
+func (bi *blobFolderBodyInfrastructure) Create(db *gorm.DB, folder *entity.FolderBody) error {
+	return nil
+}
+
+func (bi *blobFolderBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(bi.storage.moveAll(db, oldPath, newPath))
+}
+
+func (bi *blobFolderBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(bi.storage.removeAll(db, path))
+}
+
+func (bi *blobFolderBodyInfrastructure) FindAll() ([]string, error) {
+	return nil, errors.ErrUnsupported
+}
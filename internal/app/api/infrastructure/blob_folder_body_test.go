@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"file-server/internal/pkg/types"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUpdateBlobFolderBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `blob_references` SET `path`=CONCAT(?, SUBSTRING(path, ?)),`updated_at`=? WHERE path LIKE ?")).WithArgs("/new/", 7, database.AnyTime{}, "/old\\_/%").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	bi := NewBlobFolderBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	if err := bi.Update(db, "/old_/", "/new/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRemoveBlobFolderBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `blob_references` WHERE path LIKE ?")).WithArgs("/path/%").WillReturnRows(sqlmock.NewRows([]string{"path", "hash", "created_at", "updated_at"}))
+	mock.ExpectCommit()
+
+	bi := NewBlobFolderBodyInfrastructure(db, types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755})
+
+	if err := bi.Remove(db, "/path/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
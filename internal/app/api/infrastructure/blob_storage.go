@@ -0,0 +1,176 @@
+package infrastructure
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"file-server/internal/app/api/infrastructure/model"
+	"file-server/internal/pkg/types"
+	"io"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type blobStorage struct {
+	db      *gorm.DB
+	storage types.Storage
+}
+
+func (bs *blobStorage) create(db *gorm.DB, path string, body io.Reader) error {
+	if err := os.MkdirAll(bs.storage.Path, bs.storage.DirMode); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(bs.storage.Path, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	size, err := copyWithQuota(db, bs.storage, io.MultiWriter(tmp, hash), body)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), bs.storage.FileMode); err != nil {
+		return err
+	}
+
+	blob := &model.BlobModel{
+		Hash:           hex.EncodeToString(hash.Sum(nil)),
+		Size:           size,
+		ReferenceCount: 1,
+	}
+	if err := bs.store(tmp.Name(), blob.Hash); err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]interface{}{"reference_count": gorm.Expr("reference_count + 1")}),
+		}).Create(blob).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&model.BlobReferenceModel{Path: path, Hash: blob.Hash}).Error
+	})
+}
+
+func (bs *blobStorage) store(name string, hash string) error {
+	if _, err := os.Stat(bs.blobPath(hash)); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(bs.blobPath(hash)), bs.storage.DirMode); err != nil {
+		return err
+	}
+	return os.Rename(name, bs.blobPath(hash))
+}
+
+func (bs *blobStorage) read(path string) (*os.File, error) {
+	var reference model.BlobReferenceModel
+	if err := bs.db.First(&reference, "path = ?", path).Error; err != nil {
+		return nil, err
+	}
+	return os.Open(bs.blobPath(reference.Hash))
+}
+
+func (bs *blobStorage) copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var reference model.BlobReferenceModel
+		if err := tx.First(&reference, "path = ?", sourcePath).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.BlobModel{}).Where("hash = ?", reference.Hash).Update("reference_count", gorm.Expr("reference_count + 1")).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&model.BlobReferenceModel{Path: targetPath, Hash: reference.Hash}).Error
+	})
+}
+
+func (bs *blobStorage) move(db *gorm.DB, oldPath string, newPath string) error {
+	return db.Model(&model.BlobReferenceModel{}).Where("path = ?", oldPath).Update("path", newPath).Error
+}
+
+func (bs *blobStorage) moveAll(db *gorm.DB, oldPath string, newPath string) error {
+	return db.Model(&model.BlobReferenceModel{}).Where("path LIKE ?", escapeLike(oldPath)+"%").Update("path", gorm.Expr("CONCAT(?, SUBSTRING(path, ?))", newPath, utf8.RuneCountInString(oldPath)+1)).Error
+}
+
+func (bs *blobStorage) remove(db *gorm.DB, path string) error {
+	return bs.removeWhere(db, "path = ?", path)
+}
+
+func (bs *blobStorage) removeAll(db *gorm.DB, path string) error {
+	return bs.removeWhere(db, "path LIKE ?", escapeLike(path)+"%")
+}
+
+func (bs *blobStorage) removeWhere(db *gorm.DB, query string, args ...interface{}) error {
+	var unused []string
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var references []model.BlobReferenceModel
+		if err := tx.Where(query, args...).Find(&references).Error; err != nil {
+			return err
+		}
+		if len(references) == 0 {
+			return nil
+		}
+
+		if err := tx.Where(query, args...).Delete(&model.BlobReferenceModel{}).Error; err != nil {
+			return err
+		}
+
+		counts := make(map[string]int64)
+		for _, v := range references {
+			counts[v.Hash]++
+		}
+		for hash, count := range counts {
+			var blob model.BlobModel
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&blob, "hash = ?", hash).Error; err != nil {
+				return err
+			}
+
+			blob.ReferenceCount -= count
+			if 0 < blob.ReferenceCount {
+				if err := tx.Save(&blob).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := tx.Delete(&blob).Error; err != nil {
+				return err
+			}
+			unused = append(unused, hash)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, v := range unused {
+		if err := os.Remove(bs.blobPath(v)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bs *blobStorage) list() ([]string, error) {
+	paths := []string{}
+	if err := bs.db.Model(&model.BlobReferenceModel{}).Order("path").Pluck("path", &paths).Error; err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+func (bs *blobStorage) blobPath(hash string) string {
+	return bs.storage.Path + "/" + hash[:2] + "/" + hash
+}
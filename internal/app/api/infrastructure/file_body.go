@@ -1,38 +1,141 @@
 package infrastructure
 
 import (
+	"errors"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
-	"file-server/internal/pkg/config"
+	"file-server/internal/pkg/types"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
+
+	"gorm.io/gorm"
 )
 
-type fileBodyInfrastructure struct{}
+type fileBodyInfrastructure struct {
+	storage types.Storage
+}
+
+func NewFileBodyInfrastructure(storage types.Storage) repository.FileBodyRepository {
+	return &fileBodyInfrastructure{
+		storage: storage,
+	}
+}
+
+func (fi *fileBodyInfrastructure) Create(db *gorm.DB, file *entity.FileBody) error {
+	return storageError(writeFile(db, fi.storage, fi.storage.Path+file.Path, file.Body))
+}
 
-func NewFileBodyInfrastructure() repository.FileBodyRepository {
-	return &fileBodyInfrastructure{}
+func (fi *fileBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(os.Rename(fi.storage.Path+oldPath, fi.storage.Path+newPath))
 }
 
-func (fi *fileBodyInfrastructure) Create(file *entity.FileBody) error {
-	info, err := os.Lstat("./")
+func (fi *fileBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(os.Remove(fi.storage.Path + path))
+}
+
+func (fi *fileBodyInfrastructure) Copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	f, err := os.Open(fi.storage.Path + sourcePath)
 	if err != nil {
-		return err
+		return storageError(err)
 	}
-	return os.WriteFile(config.STORAGE_PATH+file.Path, file.Body, info.Mode())
+	defer f.Close()
+
+	return storageError(writeFile(db, fi.storage, fi.storage.Path+targetPath, f))
+}
+
+func (fi *fileBodyInfrastructure) Read(path string) (io.ReadSeekCloser, error) {
+	f, err := os.Open(fi.storage.Path + path)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return f, nil
 }
 
-func (fi *fileBodyInfrastructure) Update(oldPath string, newPath string) error {
-	return os.Rename(config.STORAGE_PATH+oldPath, config.STORAGE_PATH+newPath)
+func (fi *fileBodyInfrastructure) FindAll() ([]string, error) {
+	paths, err := walkStorage(fi.storage.Path, func(d fs.DirEntry) bool {
+		return d.Type().IsRegular() && !strings.HasPrefix(d.Name(), ".upload-")
+	})
+	return paths, storageError(err)
 }
 
-func (fi *fileBodyInfrastructure) Remove(path string) error {
-	return os.Remove(config.STORAGE_PATH + path)
+func (fi *fileBodyInfrastructure) PresignedURL(path string, mimeType string) (string, error) {
+	return "", nil
+}
+
+func writeFile(db *gorm.DB, storage types.Storage, path string, body io.Reader) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := copyWithQuota(db, storage, tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), storage.FileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
 }
 
-func (fi *fileBodyInfrastructure) Read(path string) (*entity.FileBody, error) {
-	body, err := os.ReadFile(config.STORAGE_PATH + path)
+func copyWithQuota(db *gorm.DB, storage types.Storage, dst io.Writer, src io.Reader) (int64, error) {
+	if storage.Quota <= 0 {
+		return io.Copy(dst, src)
+	}
+
+	remaining, err := remainingQuota(db, storage.Quota)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(dst, io.LimitReader(src, remaining+1))
 	if err != nil {
-		return nil, err
+		return n, err
+	}
+	if remaining < n {
+		return n, entity.ErrStorageQuotaExceeded
+	}
+	return n, nil
+}
+
+func walkStorage(root string, match func(fs.DirEntry) bool) ([]string, error) {
+	paths := []string{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if path == root || !match(d) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		path = "/" + filepath.ToSlash(rel)
+		if d.IsDir() {
+			path += "/"
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	return paths, err
+}
+
+func storageError(err error) error {
+	if err == nil || errors.Is(err, entity.ErrStorage) {
+		return err
 	}
-	return entity.NewFileBody(path, body), nil
+	return fmt.Errorf("%w: %w", entity.ErrStorage, err)
 }
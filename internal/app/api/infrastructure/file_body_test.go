@@ -0,0 +1,111 @@
+package infrastructure
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/types"
+	"file-server/test/database"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCreateFileBody(t *testing.T) {
+	storage := types.Storage{Path: t.TempDir(), FileMode: 0640, DirMode: 0750}
+	otherStorage := types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755}
+
+	fi := NewFileBodyInfrastructure(storage)
+	otherFi := NewFileBodyInfrastructure(otherStorage)
+
+	if err := fi.Create(nil, entity.NewFileBody("/name", strings.NewReader("file"))); err != nil {
+		t.Error(err.Error())
+	}
+
+	info, err := os.Stat(storage.Path + "/name")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("unexpected mode: %v", info.Mode().Perm())
+	}
+	if _, err := os.Stat(otherStorage.Path + "/name"); !os.IsNotExist(err) {
+		t.Error("file body is created in other storage")
+	}
+	if _, err := otherFi.Read("/name"); err == nil {
+		t.Error("file body is read from other storage")
+	}
+}
+
+func TestCreateFileBodyWithQuota(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	expectStorageUsage(mock, 0)
+	expectStorageUsage(mock, 4)
+	expectStorageUsage(mock, 4)
+
+	storage := types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755, Quota: 6}
+
+	fi := NewFileBodyInfrastructure(storage)
+
+	if err := fi.Create(db, entity.NewFileBody("/first", strings.NewReader("file"))); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := fi.Create(db, entity.NewFileBody("/second", strings.NewReader("file"))); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(storage.Path + "/second"); !os.IsNotExist(err) {
+		t.Error("file body is created over quota")
+	}
+
+	if err := fi.Copy(db, "/first", "/copy"); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	entries, err := os.ReadDir(storage.Path)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(entries) != 1 {
+		t.Errorf("unexpected entries: %v", entries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestFindAllFileBodies(t *testing.T) {
+	storage := types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0755}
+
+	fi := NewFileBodyInfrastructure(storage)
+	fo := NewFolderBodyInfrastructure(storage)
+
+	if err := fo.Create(nil, entity.NewFolderBody("/folder/")); err != nil {
+		t.Error(err.Error())
+	}
+	if err := fi.Create(nil, entity.NewFileBody("/folder/name", strings.NewReader("file"))); err != nil {
+		t.Error(err.Error())
+	}
+	if err := os.WriteFile(storage.Path+"/folder/.upload-1", []byte("tmp"), 0644); err != nil {
+		t.Error(err.Error())
+	}
+
+	files, err := fi.FindAll()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(files) != 1 || files[0] != "/folder/name" {
+		t.Errorf("unexpected file bodies: %v", files)
+	}
+
+	folders, err := fo.FindAll()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(folders) != 1 || folders[0] != "/folder/" {
+		t.Errorf("unexpected folder bodies: %v", folders)
+	}
+}
@@ -40,7 +40,23 @@ func (fi *fileInfoInfrastructure) Update(db *gorm.DB, file *entity.FileInfo) (*e
 
 func (fi *fileInfoInfrastructure) Remove(db *gorm.DB, file *entity.FileInfo) error {
 	fileModel := fi.entityToModel(file)
-	return db.Delete(fileModel).Error
+	return db.Unscoped().Delete(fileModel).Error
+}
+
+func (fi *fileInfoInfrastructure) TrashByPathPrefix(db *gorm.DB, prefix string) error {
+	return db.Where("path LIKE ?", escapeLike(prefix)+"%").Delete(&model.FileModel{}).Error
+}
+
+func (fi *fileInfoInfrastructure) RestoreByPathPrefix(db *gorm.DB, prefix string) error {
+	return db.Unscoped().Model(&model.FileModel{}).Where("path LIKE ?", escapeLike(prefix)+"%").Update("deleted_at", nil).Error
+}
+
+func (fi *fileInfoInfrastructure) FindAll(db *gorm.DB) ([]entity.FileInfo, error) {
+	var fileModels []model.FileModel
+	if err := db.Order("id").Find(&fileModels).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntities(fileModels)
 }
 
 func (fi *fileInfoInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.FileInfo, error) {
@@ -67,13 +83,39 @@ func (fi *fileInfoInfrastructure) FindOneByPath(db *gorm.DB, path string) (*enti
 	return fi.convertToEntity(&fileModel)
 }
 
+func (fi *fileInfoInfrastructure) FindAllByFolderID(db *gorm.DB, folderID uint64, query *entity.ListQuery) ([]entity.FileInfo, error) {
+	var fileModels []model.FileModel
+	if err := db.Scopes(listScope(query, entity.ListKindFile)).Find(&fileModels, "folder_id = ?", folderID).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntities(fileModels)
+}
+
+func (fi *fileInfoInfrastructure) Search(db *gorm.DB, query *entity.ListQuery) ([]entity.FileInfo, error) {
+	var fileModels []model.FileModel
+	if err := db.Scopes(listScope(query, entity.ListKindFile)).Find(&fileModels).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntities(fileModels)
+}
+
+func (fi *fileInfoInfrastructure) CountByFolderID(db *gorm.DB, folderID uint64, filter *entity.ListFilter) (int64, error) {
+	var count int64
+	if err := db.Model(&model.FileModel{}).Scopes(filterScope(filter, entity.ListKindFile)).Where("folder_id = ?", folderID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (fi *fileInfoInfrastructure) entityToModel(file *entity.FileInfo) *model.FileModel {
 	return &model.FileModel{
 		ID:        file.ID,
 		FolderID:  file.FolderID,
+		OwnerID:   file.OwnerID,
 		Name:      file.Name.Value,
 		Path:      file.Path.Value,
 		MimeType:  file.MimeType.Value,
+		Size:      file.Size,
 		IsHide:    file.IsHide,
 		CreatedAt: file.CreatedAt,
 		UpdatedAt: file.UpdatedAt,
@@ -93,6 +135,7 @@ func (fi *fileInfoInfrastructure) convertToEntity(file *model.FileModel) (*entit
 	fileEntity := &entity.FileInfo{}
 	fileEntity.ID = file.ID
 	fileEntity.FolderID = file.FolderID
+	fileEntity.OwnerID = file.OwnerID
 	if err := fileEntity.SetName(file.Name); err != nil {
 		return nil, err
 	}
@@ -102,6 +145,7 @@ func (fi *fileInfoInfrastructure) convertToEntity(file *model.FileModel) (*entit
 	if err := fileEntity.SetMimeType(file.MimeType); err != nil {
 		return nil, err
 	}
+	fileEntity.Size = file.Size
 	fileEntity.IsHide = file.IsHide
 	fileEntity.CreatedAt = file.CreatedAt
 	fileEntity.UpdatedAt = file.UpdatedAt
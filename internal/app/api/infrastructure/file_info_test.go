@@ -24,7 +24,7 @@ func TestCreateFile(t *testing.T) {
 	}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `files` (`folder_id`,`name`,`path`,`mime_type`,`is_hide`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?)")).WithArgs(file.FolderID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.IsHide, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `files` (`folder_id`,`owner_id`,`name`,`path`,`mime_type`,`size`,`is_hide`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")).WithArgs(file.FolderID, file.OwnerID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.Size, file.IsHide, database.AnyTime{}, database.AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	fi := NewFileInfoInfrastructure()
@@ -72,7 +72,7 @@ func TestCreateFiles(t *testing.T) {
 	files := []entity.FileInfo{*file}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `files` (`folder_id`,`name`,`path`,`mime_type`,`is_hide`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?)")).WithArgs(file.FolderID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.IsHide, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `files` (`folder_id`,`owner_id`,`name`,`path`,`mime_type`,`size`,`is_hide`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")).WithArgs(file.FolderID, file.OwnerID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.Size, file.IsHide, database.AnyTime{}, database.AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	fi := NewFileInfoInfrastructure()
@@ -122,7 +122,7 @@ func TestUpdateFile(t *testing.T) {
 	file.ID = 1
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `files` SET `folder_id`=?,`name`=?,`path`=?,`mime_type`=?,`is_hide`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")).WithArgs(file.FolderID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.IsHide, database.AnyTime{}, database.AnyTime{}, file.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `files` SET `folder_id`=?,`owner_id`=?,`name`=?,`path`=?,`mime_type`=?,`size`=?,`is_hide`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `files`.`deleted_at` IS NULL AND `id` = ?")).WithArgs(file.FolderID, file.OwnerID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.Size, file.IsHide, database.AnyTime{}, database.AnyTime{}, nil, file.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	fi := NewFileInfoInfrastructure()
@@ -177,13 +177,79 @@ func TestRemoveFile(t *testing.T) {
 	}
 }
 
+func TestTrashFilesByPathPrefix(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `files` SET `deleted_at`=? WHERE path LIKE ? AND `files`.`deleted_at` IS NULL")).WithArgs(database.AnyTime{}, "/a\\_b/%").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	fi := NewFileInfoInfrastructure()
+
+	if err := fi.TrashByPathPrefix(db, "/a_b/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRestoreFilesByPathPrefix(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `files` SET `deleted_at`=?,`updated_at`=? WHERE path LIKE ?")).WithArgs(nil, database.AnyTime{}, "/:trash/1/%").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	fi := NewFileInfoInfrastructure()
+
+	if err := fi.RestoreByPathPrefix(db, "/:trash/1/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestFindAllFiles(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`deleted_at` IS NULL ORDER BY id")).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "a", "/a", "mime/type", false, time.Now(), time.Now()).AddRow(2, 1, "b", "/b", "mime/type", true, time.Now(), time.Now()))
+
+	fi := NewFileInfoInfrastructure()
+
+	result, err := fi.FindAll(db)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[1].Path.Value != "/b" {
+		t.Error("failed to find files")
+	}
+}
+
 func TestFindOneFileByID(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE id = ? ORDER BY `files`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE id = ? AND `files`.`deleted_at` IS NULL ORDER BY `files`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
 
 	fi := NewFileInfoInfrastructure()
 
@@ -207,7 +273,7 @@ func TestFindOneFileByIDAndIsHide(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE id = ? and is_hide = ? ORDER BY `files`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE (id = ? and is_hide = ?) AND `files`.`deleted_at` IS NULL ORDER BY `files`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
 
 	fi := NewFileInfoInfrastructure()
 
@@ -231,7 +297,7 @@ func TestFindOneFileByPath(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE path = ? ORDER BY `files`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE path = ? AND `files`.`deleted_at` IS NULL ORDER BY `files`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
 
 	fi := NewFileInfoInfrastructure()
 
@@ -248,3 +314,95 @@ func TestFindOneFileByPath(t *testing.T) {
 		t.Error("failed to find the file by path")
 	}
 }
+
+func TestFindAllFilesByFolderID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	var ownerID uint64 = 2
+	query := &entity.ListQuery{
+		Sort:   entity.ListSortSize,
+		Order:  entity.ListOrderDesc,
+		Filter: entity.ListFilter{MimeType: "image/", Visibility: entity.Visibility{OwnerID: &ownerID}},
+		Cursor: &entity.ListCursor{Kind: entity.ListKindFile, Value: "100", ID: 3},
+		Limit:  11,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE folder_id = ? AND (size < ? OR (size = ? AND id < ?)) AND (is_hide = ? OR owner_id = ?) AND mime_type LIKE ? AND `files`.`deleted_at` IS NULL ORDER BY size DESC,id DESC LIMIT ?")).WithArgs(1, 100, 100, 3, false, 2, "image/%", 11).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "size", "is_hide", "created_at", "updated_at"}).AddRow(4, 1, "name", "/name", "image/png", 50, false, time.Now(), time.Now()))
+
+	fi := NewFileInfoInfrastructure()
+
+	result, err := fi.FindAllByFolderID(db, 1, query)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].Size != 50 {
+		t.Error("failed to find files by folder id")
+	}
+}
+
+func TestSearchFiles(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	var minSize int64 = 10
+	query := &entity.ListQuery{
+		Sort:   entity.ListSortName,
+		Order:  entity.ListOrderAsc,
+		Filter: entity.ListFilter{Path: "/a_b/", Name: "*.txt", MinSize: &minSize, Visibility: entity.Visibility{IncludeHidden: true}},
+		Limit:  11,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE path LIKE ? AND name LIKE ? AND size >= ? AND `files`.`deleted_at` IS NULL ORDER BY name ASC,id ASC LIMIT ?")).WithArgs("/a\\_b/%", "%.txt", 10, 11).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "size", "is_hide", "created_at", "updated_at"}).AddRow(4, 2, "name.txt", "/a_b/c/name.txt", "text/plain", 50, true, time.Now(), time.Now()))
+
+	fi := NewFileInfoInfrastructure()
+
+	result, err := fi.Search(db, query)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].Path.Value != "/a_b/c/name.txt" {
+		t.Error("failed to search files")
+	}
+}
+
+func TestCountFilesByFolderID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := &entity.ListFilter{UpdatedAfter: after, Visibility: entity.Visibility{IncludeHidden: true}}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `files` WHERE folder_id = ? AND updated_at >= ? AND `files`.`deleted_at` IS NULL")).WithArgs(1, after).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+	fi := NewFileInfoInfrastructure()
+
+	result, err := fi.CountByFolderID(db, 1, filter)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result != 5 {
+		t.Error("failed to count files by folder id")
+	}
+}
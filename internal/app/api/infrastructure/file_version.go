@@ -0,0 +1,90 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type fileVersionInfrastructure struct{}
+
+func NewFileVersionInfrastructure() repository.FileVersionRepository {
+	return &fileVersionInfrastructure{}
+}
+
+func (fi *fileVersionInfrastructure) Create(db *gorm.DB, fileVersion *entity.FileVersion) (*entity.FileVersion, error) {
+	fileVersionModel := fi.convertToModel(fileVersion)
+	if err := db.Create(fileVersionModel).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntity(fileVersionModel), nil
+}
+
+func (fi *fileVersionInfrastructure) Update(db *gorm.DB, fileVersion *entity.FileVersion) (*entity.FileVersion, error) {
+	fileVersionModel := fi.convertToModel(fileVersion)
+	if err := db.Save(fileVersionModel).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntity(fileVersionModel), nil
+}
+
+func (fi *fileVersionInfrastructure) Remove(db *gorm.DB, fileVersion *entity.FileVersion) error {
+	fileVersionModel := fi.convertToModel(fileVersion)
+	return db.Delete(fileVersionModel).Error
+}
+
+func (fi *fileVersionInfrastructure) FindAll(db *gorm.DB) ([]entity.FileVersion, error) {
+	var fileVersionModels []model.FileVersionModel
+	if err := db.Order("id").Find(&fileVersionModels).Error; err != nil {
+		return nil, err
+	}
+
+	fileVersions := make([]entity.FileVersion, len(fileVersionModels))
+	for i, v := range fileVersionModels {
+		fileVersions[i] = *fi.convertToEntity(&v)
+	}
+	return fileVersions, nil
+}
+
+func (fi *fileVersionInfrastructure) FindAllByFileID(db *gorm.DB, fileID uint64) ([]entity.FileVersion, error) {
+	var fileVersionModels []model.FileVersionModel
+	if err := db.Order("id DESC").Find(&fileVersionModels, "file_id = ?", fileID).Error; err != nil {
+		return nil, err
+	}
+
+	fileVersions := make([]entity.FileVersion, len(fileVersionModels))
+	for i, v := range fileVersionModels {
+		fileVersions[i] = *fi.convertToEntity(&v)
+	}
+	return fileVersions, nil
+}
+
+func (fi *fileVersionInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.FileVersion, error) {
+	var fileVersionModel model.FileVersionModel
+	if err := db.First(&fileVersionModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return fi.convertToEntity(&fileVersionModel), nil
+}
+
+func (fi *fileVersionInfrastructure) convertToModel(fileVersion *entity.FileVersion) *model.FileVersionModel {
+	return &model.FileVersionModel{
+		ID:        fileVersion.ID,
+		FileID:    fileVersion.FileID,
+		MimeType:  fileVersion.MimeType,
+		Size:      fileVersion.Size,
+		CreatedAt: fileVersion.CreatedAt,
+	}
+}
+
+func (fi *fileVersionInfrastructure) convertToEntity(fileVersion *model.FileVersionModel) *entity.FileVersion {
+	return &entity.FileVersion{
+		ID:        fileVersion.ID,
+		FileID:    fileVersion.FileID,
+		MimeType:  fileVersion.MimeType,
+		Size:      fileVersion.Size,
+		CreatedAt: fileVersion.CreatedAt,
+	}
+}
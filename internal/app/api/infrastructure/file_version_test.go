@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateFileVersion(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	file, err := entity.NewFileInfo(2, "name.txt", "/path/name.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file.ID = 3
+	fileVersion := entity.NewFileVersion(file)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `file_versions` (`file_id`,`mime_type`,`size`,`created_at`) VALUES (?,?,?,?)")).WithArgs(3, "text/plain", 0, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	fi := NewFileVersionInfrastructure()
+
+	result, err := fi.Create(db, fileVersion)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Path() != "/:versions/3/1" {
+		t.Error("failed to create file version")
+	}
+}
+
+func TestFindAllFileVersionsByFileID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `file_versions` WHERE file_id = ? ORDER BY id DESC")).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"id", "file_id", "mime_type", "created_at"}).AddRow(2, 3, "text/plain", time.Now()).AddRow(1, 3, "text/plain", time.Now()))
+
+	fi := NewFileVersionInfrastructure()
+
+	result, err := fi.FindAllByFileID(db, 3)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[0].ID != 2 {
+		t.Error("failed to find file versions")
+	}
+}
+
+func TestFindAllFileVersions(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `file_versions` ORDER BY id")).WillReturnRows(sqlmock.NewRows([]string{"id", "file_id", "mime_type", "size", "created_at"}).AddRow(1, 3, "text/plain", 5, time.Now()).AddRow(2, 4, "text/plain", 7, time.Now()))
+
+	fi := NewFileVersionInfrastructure()
+
+	result, err := fi.FindAll(db)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[1].Path() != "/:versions/4/2" || result[1].Size != 7 {
+		t.Error("failed to find file versions")
+	}
+}
+
+func TestUpdateFileVersion(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileVersion := &entity.FileVersion{ID: 1, FileID: 3, MimeType: "text/plain", Size: 5, CreatedAt: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `file_versions` SET `file_id`=?,`mime_type`=?,`size`=?,`created_at`=? WHERE `id` = ?")).WithArgs(3, "text/plain", 5, database.AnyTime{}, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	fi := NewFileVersionInfrastructure()
+
+	result, err := fi.Update(db, fileVersion)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.Size != 5 {
+		t.Error("failed to update file version")
+	}
+}
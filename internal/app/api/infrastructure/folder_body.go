@@ -3,85 +3,38 @@ package infrastructure
 import (
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
-	"file-server/internal/pkg/config"
+	"file-server/internal/pkg/types"
+	"io/fs"
 	"os"
-)
 
-type folderBodyInfrastructure struct{}
+	"gorm.io/gorm"
+)
 
-func NewFolderBodyInfrastructure() repository.FolderBodyRepository {
-	return &folderBodyInfrastructure{}
+type folderBodyInfrastructure struct {
+	storage types.Storage
 }
 
-func (fi *folderBodyInfrastructure) Create(folder *entity.FolderBody) error {
-	info, err := os.Lstat("./")
-	if err != nil {
-		return err
-	}
-
-	if err := os.MkdirAll(config.STORAGE_PATH+folder.Path, info.Mode()); err != nil {
-		return err
-	}
-
-	folders := folder.Folders
-	if 0 < len(folders) {
-		for _, v := range folders {
-			if err := fi.Create(&v); err != nil {
-				return err
-			}
-		}
-	}
-
-	files := folder.Files
-	if 0 < len(files) {
-		for _, v := range files {
-			if err := os.WriteFile(config.STORAGE_PATH+v.Path, v.Body, info.Mode()); err != nil {
-				return err
-			}
-		}
+func NewFolderBodyInfrastructure(storage types.Storage) repository.FolderBodyRepository {
+	return &folderBodyInfrastructure{
+		storage: storage,
 	}
-
-	return nil
 }
 
-func (fi *folderBodyInfrastructure) Update(oldPath string, newPath string) error {
-	return os.Rename(config.STORAGE_PATH+oldPath, config.STORAGE_PATH+newPath)
+func (fi *folderBodyInfrastructure) Create(db *gorm.DB, folder *entity.FolderBody) error {
+	return storageError(os.MkdirAll(fi.storage.Path+folder.Path, fi.storage.DirMode))
 }
 
-func (fi *folderBodyInfrastructure) Remove(path string) error {
-	return os.RemoveAll(config.STORAGE_PATH + path)
+func (fi *folderBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(os.Rename(fi.storage.Path+oldPath, fi.storage.Path+newPath))
 }
 
-func (fi *folderBodyInfrastructure) Read(path string) (*entity.FolderBody, error) {
-	entry, err := os.ReadDir(config.STORAGE_PATH + path)
-	if err != nil {
-		return nil, err
-	}
-
-	folder := entity.NewFolderBody(path)
-
-	var folders []entity.FolderBody
-	var files []entity.FileBody
-
-	for _, v := range entry {
-		if v.IsDir() {
-			f, err := fi.Read(path + v.Name() + "/")
-			if err != nil {
-				return nil, err
-			}
-			folders = append(folders, *f)
-		} else {
-			body, err := os.ReadFile(config.STORAGE_PATH + path + v.Name())
-			if err != nil {
-				return nil, err
-			}
-			file := entity.NewFileBody(config.STORAGE_PATH+path+v.Name(), body)
-			files = append(files, *file)
-		}
-	}
-
-	folder.Folders = folders
-	folder.Files = files
+func (fi *folderBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(os.RemoveAll(fi.storage.Path + path))
+}
 
-	return folder, nil
+func (fi *folderBodyInfrastructure) FindAll() ([]string, error) {
+	paths, err := walkStorage(fi.storage.Path, func(d fs.DirEntry) bool {
+		return d.IsDir()
+	})
+	return paths, storageError(err)
 }
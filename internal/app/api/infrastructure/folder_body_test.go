@@ -0,0 +1,26 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/types"
+	"os"
+	"testing"
+)
+
+func TestCreateFolderBody(t *testing.T) {
+	storage := types.Storage{Path: t.TempDir(), FileMode: 0644, DirMode: 0750}
+
+	fi := NewFolderBodyInfrastructure(storage)
+
+	if err := fi.Create(nil, entity.NewFolderBody("/folder/")); err != nil {
+		t.Error(err.Error())
+	}
+
+	info, err := os.Stat(storage.Path + "/folder")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !info.IsDir() || info.Mode().Perm() != 0750 {
+		t.Errorf("unexpected mode: %v", info.Mode())
+	}
+}
@@ -22,6 +22,26 @@ func (fi *folderInfoInfrastructure) Create(db *gorm.DB, folder *entity.FolderInf
 	return fi.convertToEntity(folderModel)
 }
 
+func (fi *folderInfoInfrastructure) Creates(db *gorm.DB, folders []entity.FolderInfo) ([]entity.FolderInfo, error) {
+	folderModels := make([]model.FolderModel, len(folders))
+	for i, v := range folders {
+		folderModels[i] = *fi.convertToModel(&v)
+	}
+	if err := db.Create(folderModels).Error; err != nil {
+		return nil, err
+	}
+
+	entities := make([]entity.FolderInfo, len(folderModels))
+	for i, v := range folderModels {
+		folder, err := fi.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		entities[i] = *folder
+	}
+	return entities, nil
+}
+
 func (fi *folderInfoInfrastructure) Update(db *gorm.DB, folder *entity.FolderInfo) (*entity.FolderInfo, error) {
 	folderModel := fi.convertToModel(folder)
 	if err := db.Save(folderModel).Error; err != nil {
@@ -44,7 +64,32 @@ func (fi *folderInfoInfrastructure) Update(db *gorm.DB, folder *entity.FolderInf
 
 func (fi *folderInfoInfrastructure) Remove(db *gorm.DB, folder *entity.FolderInfo) error {
 	folderModel := fi.convertToModel(folder)
-	return db.Delete(folderModel).Error
+	return db.Unscoped().Delete(folderModel).Error
+}
+
+func (fi *folderInfoInfrastructure) TrashByPathPrefix(db *gorm.DB, prefix string) error {
+	return db.Where("path LIKE ?", escapeLike(prefix)+"%").Delete(&model.FolderModel{}).Error
+}
+
+func (fi *folderInfoInfrastructure) RestoreByPathPrefix(db *gorm.DB, prefix string) error {
+	return db.Unscoped().Model(&model.FolderModel{}).Where("path LIKE ?", escapeLike(prefix)+"%").Update("deleted_at", nil).Error
+}
+
+func (fi *folderInfoInfrastructure) FindAll(db *gorm.DB) ([]entity.FolderInfo, error) {
+	var folderModels []model.FolderModel
+	if err := db.Order("id").Find(&folderModels).Error; err != nil {
+		return nil, err
+	}
+
+	folders := make([]entity.FolderInfo, len(folderModels))
+	for i, v := range folderModels {
+		folder, err := fi.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		folders[i] = *folder
+	}
+	return folders, nil
 }
 
 func (fi *folderInfoInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.FolderInfo, error) {
@@ -63,6 +108,65 @@ func (fi *folderInfoInfrastructure) FindOneByPath(db *gorm.DB, path string) (*en
 	return fi.convertToEntity(&folderModel)
 }
 
+func (fi *folderInfoInfrastructure) FindAllByPaths(db *gorm.DB, paths []string) ([]entity.FolderInfo, error) {
+	var folderModels []model.FolderModel
+	if err := db.Order("path").Find(&folderModels, "path IN ?", paths).Error; err != nil {
+		return nil, err
+	}
+
+	folders := make([]entity.FolderInfo, len(folderModels))
+	for i, v := range folderModels {
+		folder, err := fi.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		folders[i] = *folder
+	}
+	return folders, nil
+}
+
+func (fi *folderInfoInfrastructure) FindAllByParentFolderID(db *gorm.DB, parentFolderID uint64, query *entity.ListQuery) ([]entity.FolderInfo, error) {
+	var folderModels []model.FolderModel
+	if err := db.Scopes(listScope(query, entity.ListKindFolder)).Find(&folderModels, "parent_folder_id = ?", parentFolderID).Error; err != nil {
+		return nil, err
+	}
+
+	folders := make([]entity.FolderInfo, len(folderModels))
+	for i, v := range folderModels {
+		folder, err := fi.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		folders[i] = *folder
+	}
+	return folders, nil
+}
+
+func (fi *folderInfoInfrastructure) Search(db *gorm.DB, query *entity.ListQuery) ([]entity.FolderInfo, error) {
+	var folderModels []model.FolderModel
+	if err := db.Scopes(listScope(query, entity.ListKindFolder)).Find(&folderModels).Error; err != nil {
+		return nil, err
+	}
+
+	folders := make([]entity.FolderInfo, len(folderModels))
+	for i, v := range folderModels {
+		folder, err := fi.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		folders[i] = *folder
+	}
+	return folders, nil
+}
+
+func (fi *folderInfoInfrastructure) CountByParentFolderID(db *gorm.DB, parentFolderID uint64, filter *entity.ListFilter) (int64, error) {
+	var count int64
+	if err := db.Model(&model.FolderModel{}).Scopes(filterScope(filter, entity.ListKindFolder)).Where("parent_folder_id = ?", parentFolderID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (fi *folderInfoInfrastructure) FindOneByPathWithChildren(db *gorm.DB, path string) (*entity.FolderInfo, error) {
 	var folderModel model.FolderModel
 	if err := db.Preload("Folders").Preload("Files").First(&folderModel, "path = ?", path).Error; err != nil {
@@ -140,9 +244,11 @@ func (fi *folderInfoInfrastructure) convertToModel(folder *entity.FolderInfo) *m
 			files[i] = model.FileModel{
 				ID:        v.ID,
 				FolderID:  v.FolderID,
+				OwnerID:   v.OwnerID,
 				Name:      v.Name.Value,
 				Path:      v.Path.Value,
 				MimeType:  v.MimeType.Value,
+				Size:      v.Size,
 				IsHide:    v.IsHide,
 				CreatedAt: v.CreatedAt,
 				UpdatedAt: v.UpdatedAt,
@@ -152,6 +258,7 @@ func (fi *folderInfoInfrastructure) convertToModel(folder *entity.FolderInfo) *m
 	return &model.FolderModel{
 		ID:             folder.ID,
 		ParentFolderID: folder.ParentFolderID,
+		OwnerID:        folder.OwnerID,
 		Name:           folder.Name.Value,
 		Path:           folder.Path.Value,
 		IsHide:         folder.IsHide,
@@ -181,6 +288,7 @@ func (fi *folderInfoInfrastructure) convertToEntity(folder *model.FolderModel) (
 			f := &entity.FileInfo{}
 			f.ID = v.ID
 			f.FolderID = v.FolderID
+			f.OwnerID = v.OwnerID
 			if err := f.SetName(v.Name); err != nil {
 				return nil, err
 			}
@@ -190,6 +298,7 @@ func (fi *folderInfoInfrastructure) convertToEntity(folder *model.FolderModel) (
 			if err := f.SetMimeType(v.MimeType); err != nil {
 				return nil, err
 			}
+			f.Size = v.Size
 			f.IsHide = v.IsHide
 			f.CreatedAt = v.CreatedAt
 			f.UpdatedAt = v.UpdatedAt
@@ -199,6 +308,7 @@ func (fi *folderInfoInfrastructure) convertToEntity(folder *model.FolderModel) (
 	folderEntity := &entity.FolderInfo{}
 	folderEntity.ID = folder.ID
 	folderEntity.ParentFolderID = folder.ParentFolderID
+	folderEntity.OwnerID = folder.OwnerID
 	if err := folderEntity.SetName(folder.Name); err != nil {
 		return nil, err
 	}
@@ -24,7 +24,7 @@ func TestCreateFolder(t *testing.T) {
 	}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `folders` (`parent_folder_id`,`name`,`path`,`is_hide`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?)")).WithArgs(folder.ParentFolderID, folder.Name.Value, folder.Path.Value, folder.IsHide, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `folders` (`parent_folder_id`,`owner_id`,`name`,`path`,`is_hide`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?)")).WithArgs(folder.ParentFolderID, folder.OwnerID, folder.Name.Value, folder.Path.Value, folder.IsHide, database.AnyTime{}, database.AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	fi := NewFolderInfoInfrastructure()
@@ -59,6 +59,49 @@ func TestCreateFolder(t *testing.T) {
 	}
 }
 
+func TestCreateFolders(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	parentFolderID := uint64(1)
+	folder, err := entity.NewFolderInfo(&parentFolderID, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folders := []entity.FolderInfo{*folder}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `folders` (`parent_folder_id`,`owner_id`,`name`,`path`,`is_hide`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?)")).WithArgs(folder.ParentFolderID, folder.OwnerID, folder.Name.Value, folder.Path.Value, folder.IsHide, database.AnyTime{}, database.AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	fi := NewFolderInfoInfrastructure()
+
+	results, err := fi.Creates(db, folders)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(entity.FolderInfo{}, "ID", "CreatedAt", "UpdatedAt"),
+	}
+
+	if diff := cmp.Diff(folders, results, opts...); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, result := range results {
+		if result.ID == 0 {
+			t.Error("failed to insert id automatically")
+		}
+	}
+}
+
 func TestUpdateFolder(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
@@ -72,7 +115,7 @@ func TestUpdateFolder(t *testing.T) {
 	folder.ID = 1
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `folders` SET `parent_folder_id`=?,`name`=?,`path`=?,`is_hide`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")).WithArgs(folder.ParentFolderID, folder.Name.Value, folder.Path.Value, folder.IsHide, database.AnyTime{}, database.AnyTime{}, folder.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `folders` SET `parent_folder_id`=?,`owner_id`=?,`name`=?,`path`=?,`is_hide`=?,`created_at`=?,`updated_at`=?,`deleted_at`=? WHERE `folders`.`deleted_at` IS NULL AND `id` = ?")).WithArgs(folder.ParentFolderID, folder.OwnerID, folder.Name.Value, folder.Path.Value, folder.IsHide, database.AnyTime{}, database.AnyTime{}, nil, folder.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	fi := NewFolderInfoInfrastructure()
@@ -127,13 +170,55 @@ func TestRemoveFolder(t *testing.T) {
 	}
 }
 
+func TestTrashFoldersByPathPrefix(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `folders` SET `deleted_at`=? WHERE path LIKE ? AND `folders`.`deleted_at` IS NULL")).WithArgs(database.AnyTime{}, "/a\\_b/%").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	fi := NewFolderInfoInfrastructure()
+
+	if err := fi.TrashByPathPrefix(db, "/a_b/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRestoreFoldersByPathPrefix(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `folders` SET `deleted_at`=?,`updated_at`=? WHERE path LIKE ?")).WithArgs(nil, database.AnyTime{}, "/:trash/1/%").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	fi := NewFolderInfoInfrastructure()
+
+	if err := fi.RestoreByPathPrefix(db, "/:trash/1/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
 func TestFindOneFolderByID(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 
 	fi := NewFolderInfoInfrastructure()
 
@@ -151,13 +236,37 @@ func TestFindOneFolderByID(t *testing.T) {
 	}
 }
 
+func TestFindAllFolderByPaths(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path IN (?,?) AND `folders`.`deleted_at` IS NULL ORDER BY path")).WithArgs("/", "/path/").WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "owner_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, nil, nil, "", "/", false, time.Now(), time.Now()).AddRow(2, 1, 1, "path", "/path/", false, time.Now(), time.Now()))
+
+	fi := NewFolderInfoInfrastructure()
+
+	result, err := fi.FindAllByPaths(db, []string{"/", "/path/"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[0].OwnerID != nil || result[1].OwnerID == nil || *result[1].OwnerID != 1 {
+		t.Error("failed to find the folders by paths")
+	}
+}
+
 func TestFindOneFolderByPath(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path = ? AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 
 	fi := NewFolderInfoInfrastructure()
 
@@ -181,7 +290,7 @@ func TestFindOneFolderByPathWithChildren(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path = ? AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 
@@ -207,7 +316,7 @@ func TestFindOneFolderByPathAndIsHideWithChildren(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE path = ? and is_hide = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE (path = ? and is_hide = ?) AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs("/path/", true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", true, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
 
@@ -233,10 +342,10 @@ func TestFindOneFolderByIDWithLower(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", false, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", false, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows(nil))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ?")).WithArgs(1).WillReturnRows(sqlmock.NewRows(nil))
 
@@ -262,10 +371,10 @@ func TestFindOneFolderByIDAndIsHideWithLower(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? and is_hide = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE (id = ? and is_hide = ?) AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "path", "mime_type", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", "mime/type", true, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE id = ? and is_hide = ? ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE (id = ? and is_hide = ?) AND `folders`.`deleted_at` IS NULL ORDER BY `folders`.`id` LIMIT ?")).WithArgs(1, true, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(1, 1, "name", "/path/", true, time.Now(), time.Now()))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `files` WHERE `files`.`folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows(nil))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE `folders`.`parent_folder_id` = ? AND `is_hide` = ?")).WithArgs(1, true).WillReturnRows(sqlmock.NewRows(nil))
 
@@ -284,3 +393,66 @@ func TestFindOneFolderByIDAndIsHideWithLower(t *testing.T) {
 		t.Error("failed to find the file by id with lower")
 	}
 }
+
+func TestFindAllFoldersByParentFolderID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	query := &entity.ListQuery{
+		Sort:   entity.ListSortSize,
+		Order:  entity.ListOrderAsc,
+		Filter: entity.ListFilter{Visibility: entity.Visibility{FolderIDs: []uint64{5}}},
+		Limit:  11,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE parent_folder_id = ? AND (is_hide = ? OR id IN (?)) AND `folders`.`deleted_at` IS NULL ORDER BY name ASC,id ASC LIMIT ?")).WithArgs(1, false, 5, 11).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(5, 1, "name", "/name/", true, time.Now(), time.Now()))
+
+	fi := NewFolderInfoInfrastructure()
+
+	result, err := fi.FindAllByParentFolderID(db, 1, query)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].ID != 5 {
+		t.Error("failed to find folders by parent folder id")
+	}
+}
+
+func TestSearchFolders(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	query := &entity.ListQuery{
+		Sort:   entity.ListSortName,
+		Order:  entity.ListOrderDesc,
+		Filter: entity.ListFilter{Path: "/a/", Name: "50%", Visibility: entity.Visibility{IncludeHidden: true}},
+		Cursor: &entity.ListCursor{Kind: entity.ListKindFolder, Value: "name", ID: 3},
+		Limit:  11,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `folders` WHERE (name < ? OR (name = ? AND id < ?)) AND path LIKE ? AND path <> ? AND name LIKE ? AND `folders`.`deleted_at` IS NULL ORDER BY name DESC,id DESC LIMIT ?")).WithArgs("name", "name", 3, "/a/%", "/a/", "%50\\%%", 11).WillReturnRows(sqlmock.NewRows([]string{"id", "parent_folder_id", "name", "path", "is_hide", "created_at", "updated_at"}).AddRow(5, 1, "50% off", "/a/50% off/", false, time.Now(), time.Now()))
+
+	fi := NewFolderInfoInfrastructure()
+
+	result, err := fi.Search(db, query)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].ID != 5 {
+		t.Error("failed to search folders")
+	}
+}
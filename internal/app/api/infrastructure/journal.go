@@ -0,0 +1,87 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type journalInfrastructure struct{}
+
+func NewJournalInfrastructure() repository.JournalRepository {
+	return &journalInfrastructure{}
+}
+
+func (ji *journalInfrastructure) Create(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+	journalModel, err := ji.convertToModel(journal)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Create(journalModel).Error; err != nil {
+		return nil, err
+	}
+	return ji.convertToEntity(journalModel)
+}
+
+func (ji *journalInfrastructure) Update(db *gorm.DB, journal *entity.Journal) (*entity.Journal, error) {
+	journalModel, err := ji.convertToModel(journal)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Save(journalModel).Error; err != nil {
+		return nil, err
+	}
+	return ji.convertToEntity(journalModel)
+}
+
+func (ji *journalInfrastructure) Remove(db *gorm.DB, journal *entity.Journal) error {
+	return db.Delete(&model.JournalModel{ID: journal.ID}).Error
+}
+
+func (ji *journalInfrastructure) FindAll(db *gorm.DB) ([]entity.Journal, error) {
+	var journalModels []model.JournalModel
+	if err := db.Order("id").Find(&journalModels).Error; err != nil {
+		return nil, err
+	}
+
+	journals := make([]entity.Journal, len(journalModels))
+	for i, v := range journalModels {
+		journal, err := ji.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		journals[i] = *journal
+	}
+	return journals, nil
+}
+
+func (ji *journalInfrastructure) convertToModel(journal *entity.Journal) (*model.JournalModel, error) {
+	operations, err := json.Marshal(journal.Operations)
+	if err != nil {
+		return nil, err
+	}
+	return &model.JournalModel{
+		ID:         journal.ID,
+		Status:     string(journal.Status),
+		Operations: string(operations),
+		CreatedAt:  journal.CreatedAt,
+		UpdatedAt:  journal.UpdatedAt,
+	}, nil
+}
+
+func (ji *journalInfrastructure) convertToEntity(journal *model.JournalModel) (*entity.Journal, error) {
+	var operations []entity.JournalOperation
+	if err := json.Unmarshal([]byte(journal.Operations), &operations); err != nil {
+		return nil, err
+	}
+	return &entity.Journal{
+		ID:         journal.ID,
+		Status:     entity.JournalStatus(journal.Status),
+		Operations: operations,
+		CreatedAt:  journal.CreatedAt,
+		UpdatedAt:  journal.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateJournal(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	journal := entity.NewJournal()
+	journal.Stage(entity.JournalOperation{Kind: entity.JournalMoveFile, Path: "/old", NewPath: "/new"})
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `journals` (`status`,`operations`,`created_at`,`updated_at`) VALUES (?,?,?,?)")).WithArgs("pending", `[{"kind":"move_file","path":"/old","new_path":"/new"}]`, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ji := NewJournalInfrastructure()
+
+	result, err := ji.Create(db, journal)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || len(result.Operations) != 1 {
+		t.Error("failed to create journal")
+	}
+}
+
+func TestFindAllJournals(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `journals` ORDER BY id")).WillReturnRows(sqlmock.NewRows([]string{"id", "status", "operations", "created_at", "updated_at"}).AddRow(1, "committed", `[{"kind":"remove_folder","path":"/:trash/1/"}]`, time.Now(), time.Now()))
+
+	ji := NewJournalInfrastructure()
+
+	result, err := ji.FindAll(db)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || !result[0].IsCommitted() || result[0].Operations[0].Kind != entity.JournalRemoveFolder {
+		t.Error("failed to find journals")
+	}
+}
@@ -0,0 +1,90 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+func listScope(query *entity.ListQuery, kind entity.ListKind) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		column := query.Sort.Column(kind)
+		operator, direction := ">", "ASC"
+		if query.Order == entity.ListOrderDesc {
+			operator, direction = "<", "DESC"
+		}
+
+		if query.Cursor != nil && query.Cursor.ID != 0 {
+			value, err := query.CursorValue()
+			if err != nil {
+				db.AddError(err)
+				return db
+			}
+			db = db.Where(fmt.Sprintf("%s %s ? OR (%s = ? AND id %s ?)", column, operator, column, operator), value, value, query.Cursor.ID)
+		}
+
+		return filterScope(&query.Filter, kind)(db).Order(column + " " + direction).Order("id " + direction).Limit(query.Limit)
+	}
+}
+
+func filterScope(filter *entity.ListFilter, kind entity.ListKind) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if !filter.Visibility.IncludeHidden {
+			conditions := []string{"is_hide = ?"}
+			args := []interface{}{false}
+			if filter.Visibility.OwnerID != nil {
+				conditions = append(conditions, "owner_id = ?")
+				args = append(args, *filter.Visibility.OwnerID)
+			}
+			if kind == entity.ListKindFolder && 0 < len(filter.Visibility.FolderIDs) {
+				conditions = append(conditions, "id IN ?")
+				args = append(args, filter.Visibility.FolderIDs)
+			}
+			db = db.Where(strings.Join(conditions, " OR "), args...)
+		}
+		if filter.Path != "" {
+			db = db.Where("path LIKE ?", escapeLike(filter.Path)+"%")
+			if kind == entity.ListKindFolder {
+				db = db.Where("path <> ?", filter.Path)
+			}
+		}
+		if filter.Name != "" {
+			db = db.Where("name LIKE ?", namePattern(filter.Name))
+		}
+		if kind == entity.ListKindFile && filter.MimeType != "" {
+			db = db.Where("mime_type LIKE ?", escapeLike(filter.MimeType)+"%")
+		}
+		if kind == entity.ListKindFile && filter.MinSize != nil {
+			db = db.Where("size >= ?", *filter.MinSize)
+		}
+		if kind == entity.ListKindFile && filter.MaxSize != nil {
+			db = db.Where("size <= ?", *filter.MaxSize)
+		}
+		if !filter.CreatedAfter.IsZero() {
+			db = db.Where("created_at >= ?", filter.CreatedAfter)
+		}
+		if !filter.CreatedBefore.IsZero() {
+			db = db.Where("created_at < ?", filter.CreatedBefore)
+		}
+		if !filter.UpdatedAfter.IsZero() {
+			db = db.Where("updated_at >= ?", filter.UpdatedAfter)
+		}
+		if !filter.UpdatedBefore.IsZero() {
+			db = db.Where("updated_at < ?", filter.UpdatedBefore)
+		}
+		return db
+	}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer("%", "\\%", "_", "\\_").Replace(value)
+}
+
+func namePattern(name string) string {
+	if !strings.ContainsAny(name, "*?") {
+		return "%" + escapeLike(name) + "%"
+	}
+	return strings.NewReplacer("*", "%", "?", "_").Replace(escapeLike(name))
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type AccessControlModel struct {
+	ID         uint64
+	FolderID   uint64
+	UserID     uint64
+	Permission string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (am *AccessControlModel) TableName() string {
+	return "access_control_entries"
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type APIKeyModel struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Prefix     string
+	Hash       string
+	Scope      string
+	FolderID   *uint64
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (am *APIKeyModel) TableName() string {
+	return "api_keys"
+}
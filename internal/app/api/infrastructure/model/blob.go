@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type BlobModel struct {
+	Hash           string `gorm:"primaryKey"`
+	Size           int64
+	ReferenceCount int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (bm *BlobModel) TableName() string {
+	return "blobs"
+}
+
+type BlobReferenceModel struct {
+	Path      string `gorm:"primaryKey"`
+	Hash      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (bm *BlobReferenceModel) TableName() string {
+	return "blob_references"
+}
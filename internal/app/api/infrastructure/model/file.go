@@ -1,16 +1,23 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type FileModel struct {
 	ID        uint64
 	FolderID  uint64
+	OwnerID   *uint64
 	Name      string
 	Path      string
 	MimeType  string
+	Size      int64
 	IsHide    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt
 }
 
 func (fm *FileModel) TableName() string {
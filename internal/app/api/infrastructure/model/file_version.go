@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type FileVersionModel struct {
+	ID        uint64
+	FileID    uint64
+	MimeType  string
+	Size      int64
+	CreatedAt time.Time
+}
+
+func (fm *FileVersionModel) TableName() string {
+	return "file_versions"
+}
@@ -1,10 +1,15 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type FolderModel struct {
 	ID             uint64
 	ParentFolderID *uint64
+	OwnerID        *uint64
 	Name           string
 	Path           string
 	IsHide         bool
@@ -12,6 +17,7 @@ type FolderModel struct {
 	Files          []FileModel   `gorm:"foreignkey:FolderID"`
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt
 }
 
 func (fm *FolderModel) TableName() string {
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type JournalModel struct {
+	ID         uint64
+	Status     string
+	Operations string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (jm *JournalModel) TableName() string {
+	return "journals"
+}
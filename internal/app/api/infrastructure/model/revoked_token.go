@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type RevokedTokenModel struct {
+	ID        string
+	UserID    uint64
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (rm *RevokedTokenModel) TableName() string {
+	return "revoked_tokens"
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type ShareLinkModel struct {
+	ID            uint64
+	Token         string
+	UserID        uint64
+	FileID        *uint64
+	FolderID      *uint64
+	Password      string
+	ExpiresAt     *time.Time
+	MaxDownloads  *uint64
+	DownloadCount uint64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (sm *ShareLinkModel) TableName() string {
+	return "share_links"
+}
@@ -0,0 +1,9 @@
+package model
+
+type StorageLockModel struct {
+	ID uint8 `gorm:"primaryKey"`
+}
+
+func (sm *StorageLockModel) TableName() string {
+	return "storage_locks"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type TrashItemModel struct {
+	ID             uint64
+	UserID         *uint64
+	FolderID       *uint64
+	FileID         *uint64
+	ParentFolderID *uint64
+	Name           string
+	Path           string
+	CreatedAt      time.Time
+}
+
+func (tm *TrashItemModel) TableName() string {
+	return "trash_items"
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+type UploadSessionModel struct {
+	ID        string
+	UserID    uint64
+	FolderID  uint64
+	Name      string
+	Size      int64
+	Offset    int64
+	IsHide    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (um *UploadSessionModel) TableName() string {
+	return "upload_sessions"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type UserModel struct {
+	ID               uint64
+	Name             string
+	Password         string
+	IsAdmin          bool
+	IsDisabled       bool
+	TokensValidAfter *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (um *UserModel) TableName() string {
+	return "users"
+}
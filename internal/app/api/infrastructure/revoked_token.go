@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type revokedTokenInfrastructure struct{}
+
+func NewRevokedTokenInfrastructure() repository.RevokedTokenRepository {
+	return &revokedTokenInfrastructure{}
+}
+
+func (ri *revokedTokenInfrastructure) Create(db *gorm.DB, token *entity.RevokedToken) (*entity.RevokedToken, error) {
+	tokenModel := ri.convertToModel(token)
+	if err := db.Create(tokenModel).Error; err != nil {
+		return nil, err
+	}
+	return ri.convertToEntity(tokenModel), nil
+}
+
+func (ri *revokedTokenInfrastructure) RemoveByExpiresAtBefore(db *gorm.DB, expiresAt time.Time) error {
+	return db.Where("expires_at < ?", expiresAt).Delete(&model.RevokedTokenModel{}).Error
+}
+
+func (ri *revokedTokenInfrastructure) FindOneByID(db *gorm.DB, id string) (*entity.RevokedToken, error) {
+	var tokenModel model.RevokedTokenModel
+	if err := db.First(&tokenModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ri.convertToEntity(&tokenModel), nil
+}
+
+func (ri *revokedTokenInfrastructure) convertToModel(token *entity.RevokedToken) *model.RevokedTokenModel {
+	return &model.RevokedTokenModel{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
+
+func (ri *revokedTokenInfrastructure) convertToEntity(token *model.RevokedTokenModel) *entity.RevokedToken {
+	return &entity.RevokedToken{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateRevokedToken(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	token := entity.NewRevokedToken("id", 1, time.Now())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `revoked_tokens` (`id`,`user_id`,`expires_at`,`created_at`) VALUES (?,?,?,?)")).WithArgs("id", 1, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ri := NewRevokedTokenInfrastructure()
+
+	result, err := ri.Create(db, token)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != "id" || result.UserID != 1 {
+		t.Error("failed to create revoked token")
+	}
+}
+
+func TestRemoveRevokedTokenByExpiresAtBefore(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `revoked_tokens` WHERE expires_at < ?")).WithArgs(database.AnyTime{}).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	ri := NewRevokedTokenInfrastructure()
+
+	if err := ri.RemoveByExpiresAtBefore(db, time.Now()); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestFindOneRevokedTokenByID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `revoked_tokens` WHERE id = ? ORDER BY `revoked_tokens`.`id` LIMIT ?")).WithArgs("id", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "expires_at", "created_at"}).AddRow("id", 1, time.Now(), time.Now()))
+
+	ri := NewRevokedTokenInfrastructure()
+
+	result, err := ri.FindOneByID(db, "id")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != "id" || result.UserID != 1 {
+		t.Error("failed to find revoked token")
+	}
+}
@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/types"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"gorm.io/gorm"
+)
+
+type s3FileBodyInfrastructure struct {
+	storage *s3Storage
+}
+
+func NewS3FileBodyInfrastructure(client *minio.Client, bucket types.Bucket) repository.FileBodyRepository {
+	return &s3FileBodyInfrastructure{
+		storage: &s3Storage{client: client, bucket: bucket},
+	}
+}
+
+func (si *s3FileBodyInfrastructure) Create(db *gorm.DB, file *entity.FileBody) error {
+	return storageError(si.storage.create(db, file.Path, file.Body))
+}
+
+func (si *s3FileBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(si.storage.move(oldPath, newPath))
+}
+
+func (si *s3FileBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(si.storage.remove(path))
+}
+
+func (si *s3FileBodyInfrastructure) Copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	return storageError(si.storage.copy(db, sourcePath, targetPath))
+}
+
+func (si *s3FileBodyInfrastructure) Read(path string) (io.ReadSeekCloser, error) {
+	object, err := si.storage.read(path)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return object, nil
+}
+
+func (si *s3FileBodyInfrastructure) FindAll() ([]string, error) {
+	paths, err := si.storage.listAll()
+	return paths, storageError(err)
+}
+
+func (si *s3FileBodyInfrastructure) PresignedURL(path string, mimeType string) (string, error) {
+	return si.storage.presign(path, mimeType)
+}
@@ -0,0 +1,251 @@
+package infrastructure
+
+import (
+	"bytes"
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/types"
+	"file-server/test/database"
+	"file-server/test/s3"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+
+	body := bytes.Repeat([]byte("file"), 3<<20)
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Create(nil, entity.NewFileBody("/path/name", bytes.NewReader(body))); err != nil {
+		t.Error(err.Error())
+	}
+
+	if v, ok := server.Get("path/name"); !ok || !bytes.Equal(v, body) {
+		t.Error("failed to upload file body")
+	}
+	if server.Uploads() != 0 {
+		t.Error("multipart upload is not completed")
+	}
+}
+
+func TestUpdateS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("old", []byte("file"))
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Update(nil, "/old", "/new"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if v, ok := server.Get("new"); !ok || string(v) != "file" {
+		t.Error("failed to move file body")
+	}
+	if _, ok := server.Get("old"); ok {
+		t.Error("old file body is remaining")
+	}
+}
+
+func TestRemoveS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("path", []byte("file"))
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Remove(nil, "/path"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if _, ok := server.Get("path"); ok {
+		t.Error("failed to remove file body")
+	}
+}
+
+func TestCopyS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("source", []byte("file"))
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Copy(nil, "/source", "/target"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if v, ok := server.Get("target"); !ok || string(v) != "file" {
+		t.Error("failed to copy file body")
+	}
+	if _, ok := server.Get("source"); !ok {
+		t.Error("source file body is removed")
+	}
+}
+
+func TestReadS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("path", []byte("file"))
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	body, err := si.Read("/path")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer body.Close()
+
+	if _, err := body.Seek(1, io.SeekStart); err != nil {
+		t.Error(err.Error())
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if string(b) != "ile" {
+		t.Errorf("unexpected body: %s", string(b))
+	}
+
+	if _, err := si.Read("/missing"); err == nil {
+		t.Error("missing file body is read")
+	}
+}
+
+func TestPresignedURLS3FileBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PresignExpiration: time.Minute}
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	v, err := si.PresignedURL("/path/name", "text/plain")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if !strings.HasSuffix(u.Path, "/bucket/path/name") {
+		t.Errorf("unexpected path: %s", u.Path)
+	}
+	if u.Query().Get("X-Amz-Signature") == "" || u.Query().Get("X-Amz-Expires") != "60" {
+		t.Errorf("unexpected query: %s", u.RawQuery)
+	}
+	if u.Query().Get("response-content-type") != "text/plain" {
+		t.Errorf("unexpected content type: %s", u.Query().Get("response-content-type"))
+	}
+
+	si = NewS3FileBodyInfrastructure(client, types.Bucket{Name: "bucket"})
+
+	if v, err := si.PresignedURL("/path/name", "text/plain"); err != nil || v != "" {
+		t.Error("presigned url is issued while disabled")
+	}
+}
+
+func TestCreateS3FileBodyWithQuota(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20, Quota: 8}
+
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	expectStorageUsage(mock, 4)
+	expectStorageUsage(mock, 8)
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Create(db, entity.NewFileBody("/fit", strings.NewReader("body"))); err != nil {
+		t.Error(err.Error())
+	}
+	if err := si.Create(db, entity.NewFileBody("/over", strings.NewReader("x"))); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Errorf("expected quota error, got %v", err)
+	}
+	if _, ok := server.Get("over"); ok {
+		t.Error("file body exceeding the quota is uploaded")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestCopyS3FileBodyWithQuota(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20, Quota: 6}
+	server.Put("source", []byte("file"))
+
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	expectStorageUsage(mock, 4)
+
+	si := NewS3FileBodyInfrastructure(client, bucket)
+
+	if err := si.Copy(db, "/source", "/target"); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Errorf("expected quota error, got %v", err)
+	}
+	if _, ok := server.Get("target"); ok {
+		t.Error("file body exceeding the quota is copied")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,37 @@
+package infrastructure
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/types"
+
+	"github.com/minio/minio-go/v7"
+	"gorm.io/gorm"
+)
+
+type s3FolderBodyInfrastructure struct {
+	storage *s3Storage
+}
+
+func NewS3FolderBodyInfrastructure(client *minio.Client, bucket types.Bucket) repository.FolderBodyRepository {
+	return &s3FolderBodyInfrastructure{
+		storage: &s3Storage{client: client, bucket: bucket},
+	}
+}
+
+func (si *s3FolderBodyInfrastructure) Create(db *gorm.DB, folder *entity.FolderBody) error {
+	return nil
+}
+
+func (si *s3FolderBodyInfrastructure) Update(db *gorm.DB, oldPath string, newPath string) error {
+	return storageError(si.storage.moveAll(oldPath, newPath))
+}
+
+func (si *s3FolderBodyInfrastructure) Remove(db *gorm.DB, path string) error {
+	return storageError(si.storage.removeAll(path))
+}
+
+func (si *s3FolderBodyInfrastructure) FindAll() ([]string, error) {
+	return nil, errors.ErrUnsupported
+}
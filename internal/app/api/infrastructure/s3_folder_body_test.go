@@ -0,0 +1,56 @@
+package infrastructure
+
+import (
+	"file-server/internal/pkg/types"
+	"file-server/test/s3"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateS3FolderBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("old/file", []byte("file"))
+	server.Put("old/folder/file", []byte("file"))
+	server.Put("older/file", []byte("file"))
+
+	si := NewS3FolderBodyInfrastructure(client, bucket)
+
+	if err := si.Update(nil, "/old/", "/new/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if keys := server.Keys(); !reflect.DeepEqual(keys, []string{"new/file", "new/folder/file", "older/file"}) {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+}
+
+func TestRemoveS3FolderBody(t *testing.T) {
+	server := s3.NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	bucket := types.Bucket{Name: "bucket", PartSize: 5 << 20}
+	server.Put("path/file", []byte("file"))
+	server.Put("path/folder/file", []byte("file"))
+	server.Put("paths/file", []byte("file"))
+
+	si := NewS3FolderBodyInfrastructure(client, bucket)
+
+	if err := si.Remove(nil, "/path/"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if keys := server.Keys(); !reflect.DeepEqual(keys, []string{"paths/file"}) {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+}
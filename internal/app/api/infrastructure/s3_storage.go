@@ -0,0 +1,182 @@
+package infrastructure
+
+import (
+	"context"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/types"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"gorm.io/gorm"
+)
+
+func NewS3Client(bucket types.Bucket) (*minio.Client, error) {
+	return minio.New(bucket.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(bucket.AccessKey, bucket.SecretKey, ""),
+		Secure: bucket.UseSSL,
+		Region: bucket.Region,
+	})
+}
+
+type s3Storage struct {
+	client *minio.Client
+	bucket types.Bucket
+}
+
+func (ss *s3Storage) create(db *gorm.DB, path string, body io.Reader) error {
+	var quota *quotaReader
+	if 0 < ss.bucket.Quota {
+		remaining, err := remainingQuota(db, ss.bucket.Quota)
+		if err != nil {
+			return err
+		}
+		quota = &quotaReader{r: body, remaining: remaining}
+		body = quota
+	}
+
+	_, err := ss.client.PutObject(context.Background(), ss.bucket.Name, ss.key(path), body, -1, minio.PutObjectOptions{
+		PartSize: ss.bucket.PartSize,
+	})
+	if err != nil && quota != nil && quota.remaining < 0 {
+		return entity.ErrStorageQuotaExceeded
+	}
+	return err
+}
+
+func (ss *s3Storage) read(path string) (*minio.Object, error) {
+	object, err := ss.client.GetObject(context.Background(), ss.bucket.Name, ss.key(path), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
+
+func (ss *s3Storage) copy(db *gorm.DB, sourcePath string, targetPath string) error {
+	if 0 < ss.bucket.Quota {
+		remaining, err := remainingQuota(db, ss.bucket.Quota)
+		if err != nil {
+			return err
+		}
+		info, err := ss.client.StatObject(context.Background(), ss.bucket.Name, ss.key(sourcePath), minio.StatObjectOptions{})
+		if err != nil {
+			return err
+		}
+		if remaining < info.Size {
+			return entity.ErrStorageQuotaExceeded
+		}
+	}
+	return ss.compose(sourcePath, targetPath)
+}
+
+func (ss *s3Storage) compose(sourcePath string, targetPath string) error {
+	_, err := ss.client.ComposeObject(context.Background(), minio.CopyDestOptions{
+		Bucket: ss.bucket.Name,
+		Object: ss.key(targetPath),
+	}, minio.CopySrcOptions{
+		Bucket: ss.bucket.Name,
+		Object: ss.key(sourcePath),
+	})
+	return err
+}
+
+func (ss *s3Storage) move(oldPath string, newPath string) error {
+	if err := ss.compose(oldPath, newPath); err != nil {
+		return err
+	}
+	return ss.remove(oldPath)
+}
+
+func (ss *s3Storage) moveAll(oldPath string, newPath string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for object := range ss.list(ctx, oldPath) {
+		if object.Err != nil {
+			return object.Err
+		}
+		if err := ss.compose("/"+object.Key, newPath+strings.TrimPrefix(object.Key, ss.key(oldPath))); err != nil {
+			return err
+		}
+	}
+	return ss.removeAll(oldPath)
+}
+
+func (ss *s3Storage) remove(path string) error {
+	return ss.client.RemoveObject(context.Background(), ss.bucket.Name, ss.key(path), minio.RemoveObjectOptions{})
+}
+
+func (ss *s3Storage) removeAll(path string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var err error
+	for v := range ss.client.RemoveObjects(ctx, ss.bucket.Name, ss.list(ctx, path), minio.RemoveObjectsOptions{}) {
+		if err == nil {
+			err = v.Err
+		}
+	}
+	return err
+}
+
+func (ss *s3Storage) presign(path string, mimeType string) (string, error) {
+	if ss.bucket.PresignExpiration <= 0 {
+		return "", nil
+	}
+
+	params := url.Values{}
+	params.Set("response-content-type", mimeType)
+	u, err := ss.client.PresignedGetObject(context.Background(), ss.bucket.Name, ss.key(path), ss.bucket.PresignExpiration, params)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (ss *s3Storage) list(ctx context.Context, path string) <-chan minio.ObjectInfo {
+	return ss.client.ListObjects(ctx, ss.bucket.Name, minio.ListObjectsOptions{
+		Prefix:    ss.key(path),
+		Recursive: true,
+	})
+}
+
+func (ss *s3Storage) listAll() ([]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	paths := []string{}
+	for object := range ss.list(ctx, "/") {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		paths = append(paths, "/"+object.Key)
+	}
+	return paths, nil
+}
+
+func (ss *s3Storage) key(path string) string {
+	return strings.TrimPrefix(path, "/")
+}
+
+type quotaReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (r *quotaReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, entity.ErrStorageQuotaExceeded
+	}
+	return n, err
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type shareLinkInfrastructure struct{}
+
+func NewShareLinkInfrastructure() repository.ShareLinkRepository {
+	return &shareLinkInfrastructure{}
+}
+
+func (si *shareLinkInfrastructure) Create(db *gorm.DB, shareLink *entity.ShareLink) (*entity.ShareLink, error) {
+	shareLinkModel := si.convertToModel(shareLink)
+	if err := db.Create(shareLinkModel).Error; err != nil {
+		return nil, err
+	}
+	return si.convertToEntity(shareLinkModel), nil
+}
+
+func (si *shareLinkInfrastructure) Remove(db *gorm.DB, shareLink *entity.ShareLink) error {
+	shareLinkModel := si.convertToModel(shareLink)
+	return db.Delete(shareLinkModel).Error
+}
+
+func (si *shareLinkInfrastructure) IncrementDownloadCount(db *gorm.DB, shareLink *entity.ShareLink) (bool, error) {
+	result := db.Model(&model.ShareLinkModel{}).Where("id = ? AND (max_downloads IS NULL OR download_count < max_downloads)", shareLink.ID).Update("download_count", gorm.Expr("download_count + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	shareLink.DownloadCount++
+	return true, nil
+}
+
+func (si *shareLinkInfrastructure) FindAllByUserID(db *gorm.DB, userID uint64) ([]entity.ShareLink, error) {
+	var shareLinkModels []model.ShareLinkModel
+	if err := db.Order("id").Find(&shareLinkModels, "user_id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+
+	shareLinks := make([]entity.ShareLink, len(shareLinkModels))
+	for i, v := range shareLinkModels {
+		shareLinks[i] = *si.convertToEntity(&v)
+	}
+	return shareLinks, nil
+}
+
+func (si *shareLinkInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.ShareLink, error) {
+	var shareLinkModel model.ShareLinkModel
+	if err := db.First(&shareLinkModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return si.convertToEntity(&shareLinkModel), nil
+}
+
+func (si *shareLinkInfrastructure) FindOneByToken(db *gorm.DB, token string) (*entity.ShareLink, error) {
+	var shareLinkModel model.ShareLinkModel
+	if err := db.First(&shareLinkModel, "token = ?", token).Error; err != nil {
+		return nil, err
+	}
+	return si.convertToEntity(&shareLinkModel), nil
+}
+
+func (si *shareLinkInfrastructure) convertToModel(shareLink *entity.ShareLink) *model.ShareLinkModel {
+	return &model.ShareLinkModel{
+		ID:            shareLink.ID,
+		Token:         shareLink.Token,
+		UserID:        shareLink.UserID,
+		FileID:        shareLink.FileID,
+		FolderID:      shareLink.FolderID,
+		Password:      shareLink.Password,
+		ExpiresAt:     shareLink.ExpiresAt,
+		MaxDownloads:  shareLink.MaxDownloads,
+		DownloadCount: shareLink.DownloadCount,
+		CreatedAt:     shareLink.CreatedAt,
+		UpdatedAt:     shareLink.UpdatedAt,
+	}
+}
+
+func (si *shareLinkInfrastructure) convertToEntity(shareLink *model.ShareLinkModel) *entity.ShareLink {
+	return &entity.ShareLink{
+		ID:            shareLink.ID,
+		Token:         shareLink.Token,
+		UserID:        shareLink.UserID,
+		FileID:        shareLink.FileID,
+		FolderID:      shareLink.FolderID,
+		Password:      shareLink.Password,
+		ExpiresAt:     shareLink.ExpiresAt,
+		MaxDownloads:  shareLink.MaxDownloads,
+		DownloadCount: shareLink.DownloadCount,
+		CreatedAt:     shareLink.CreatedAt,
+		UpdatedAt:     shareLink.UpdatedAt,
+	}
+}
@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateShareLink(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileID := uint64(1)
+	shareLink, err := entity.NewShareLink(1, &fileID, nil, "", nil, nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `share_links` (`token`,`user_id`,`file_id`,`folder_id`,`password`,`expires_at`,`max_downloads`,`download_count`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")).WithArgs(shareLink.Token, 1, 1, nil, "", nil, nil, 0, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	si := NewShareLinkInfrastructure()
+
+	result, err := si.Create(db, shareLink)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || *result.FileID != 1 {
+		t.Error("failed to create share link")
+	}
+}
+
+func TestIncrementShareLinkDownloadCount(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `share_links` SET `download_count`=download_count + 1,`updated_at`=? WHERE id = ? AND (max_downloads IS NULL OR download_count < max_downloads)")).WithArgs(database.AnyTime{}, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	si := NewShareLinkInfrastructure()
+
+	shareLink := &entity.ShareLink{ID: 1, DownloadCount: 2}
+	result, err := si.IncrementDownloadCount(db, shareLink)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if !result || shareLink.DownloadCount != 3 {
+		t.Error("failed to increment download count")
+	}
+}
+
+func TestIncrementShareLinkDownloadCountExhausted(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `share_links` SET `download_count`=download_count + 1,`updated_at`=? WHERE id = ? AND (max_downloads IS NULL OR download_count < max_downloads)")).WithArgs(database.AnyTime{}, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	si := NewShareLinkInfrastructure()
+
+	result, err := si.IncrementDownloadCount(db, &entity.ShareLink{ID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result {
+		t.Error("exhausted share link is incremented")
+	}
+}
+
+func TestFindOneShareLinkByToken(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `share_links` WHERE token = ? ORDER BY `share_links`.`id` LIMIT ?")).WithArgs("token", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "token", "user_id", "file_id", "folder_id", "password", "expires_at", "max_downloads", "download_count", "created_at", "updated_at"}).AddRow(1, "token", 1, nil, 1, "", nil, 10, 3, time.Now(), time.Now()))
+
+	si := NewShareLinkInfrastructure()
+
+	result, err := si.FindOneByToken(db, "token")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || *result.FolderID != 1 || *result.MaxDownloads != 10 || result.DownloadCount != 3 {
+		t.Error("failed to find the share link by token")
+	}
+}
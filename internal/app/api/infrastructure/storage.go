@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/config"
+	"file-server/internal/pkg/types"
+
+	"gorm.io/gorm"
+)
+
+func NewBodyInfrastructure(db *gorm.DB) (repository.FolderBodyRepository, repository.FileBodyRepository, error) {
+	storage := types.Storage{
+		Path:     config.STORAGE_PATH,
+		FileMode: config.STORAGE_FILE_MODE,
+		DirMode:  config.STORAGE_DIR_MODE,
+		Quota:    config.STORAGE_QUOTA,
+	}
+
+	switch config.STORAGE_DRIVER {
+	case "s3":
+		bucket := types.Bucket{
+			Endpoint:          config.S3_ENDPOINT,
+			Region:            config.S3_REGION,
+			Name:              config.S3_BUCKET,
+			AccessKey:         config.S3_ACCESS_KEY,
+			SecretKey:         config.S3_SECRET_KEY,
+			UseSSL:            config.S3_USE_SSL,
+			PartSize:          config.S3_PART_SIZE,
+			PresignExpiration: config.S3_PRESIGN_EXPIRATION,
+			Quota:             config.STORAGE_QUOTA,
+		}
+		client, err := NewS3Client(bucket)
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewS3FolderBodyInfrastructure(client, bucket), NewS3FileBodyInfrastructure(client, bucket), nil
+	case "blob":
+		return NewBlobFolderBodyInfrastructure(db, storage), NewBlobFileBodyInfrastructure(db, storage), nil
+	}
+	return NewFolderBodyInfrastructure(storage), NewFileBodyInfrastructure(storage), nil
+}
@@ -0,0 +1,23 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func remainingQuota(db *gorm.DB, quota int64) (int64, error) {
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&model.StorageLockModel{}, 1).Error; err != nil {
+		return 0, err
+	}
+
+	var usage int64
+	if err := db.Raw("SELECT (SELECT COALESCE(SUM(size), 0) FROM files) + (SELECT COALESCE(SUM(size), 0) FROM file_versions)").Scan(&usage).Error; err != nil {
+		return 0, err
+	}
+	if quota < usage {
+		return 0, nil
+	}
+	return quota - usage, nil
+}
@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"file-server/test/database"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func expectStorageUsage(mock sqlmock.Sqlmock, usage int64) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `storage_locks` WHERE `storage_locks`.`id` = ? ORDER BY `storage_locks`.`id` LIMIT ? FOR UPDATE")).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT (SELECT COALESCE(SUM(size), 0) FROM files) + (SELECT COALESCE(SUM(size), 0) FROM file_versions)")).WillReturnRows(sqlmock.NewRows([]string{"usage"}).AddRow(usage))
+}
+
+func TestRemainingQuota(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	expectStorageUsage(mock, 4)
+	expectStorageUsage(mock, 8)
+
+	remaining, err := remainingQuota(db, 6)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if remaining != 2 {
+		t.Errorf("unexpected remaining quota: %d", remaining)
+	}
+
+	remaining, err = remainingQuota(db, 6)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if remaining != 0 {
+		t.Errorf("unexpected remaining quota: %d", remaining)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
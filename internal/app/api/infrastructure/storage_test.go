@@ -0,0 +1,50 @@
+package infrastructure
+
+import (
+	"file-server/internal/pkg/config"
+	"testing"
+)
+
+func TestNewBodyInfrastructure(t *testing.T) {
+	defer func(driver string, endpoint string, bucket string) {
+		config.STORAGE_DRIVER, config.S3_ENDPOINT, config.S3_BUCKET = driver, endpoint, bucket
+	}(config.STORAGE_DRIVER, config.S3_ENDPOINT, config.S3_BUCKET)
+	config.S3_ENDPOINT = "localhost:9000"
+	config.S3_BUCKET = "bucket"
+
+	config.STORAGE_DRIVER = "local"
+	folderBody, fileBody, err := NewBodyInfrastructure(nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if _, ok := folderBody.(*folderBodyInfrastructure); !ok {
+		t.Error("failed to create local folder body infrastructure")
+	}
+	if _, ok := fileBody.(*fileBodyInfrastructure); !ok {
+		t.Error("failed to create local file body infrastructure")
+	}
+
+	config.STORAGE_DRIVER = "blob"
+	folderBody, fileBody, err = NewBodyInfrastructure(nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if _, ok := folderBody.(*blobFolderBodyInfrastructure); !ok {
+		t.Error("failed to create blob folder body infrastructure")
+	}
+	if _, ok := fileBody.(*blobFileBodyInfrastructure); !ok {
+		t.Error("failed to create blob file body infrastructure")
+	}
+
+	config.STORAGE_DRIVER = "s3"
+	folderBody, fileBody, err = NewBodyInfrastructure(nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if _, ok := folderBody.(*s3FolderBodyInfrastructure); !ok {
+		t.Error("failed to create s3 folder body infrastructure")
+	}
+	if _, ok := fileBody.(*s3FileBodyInfrastructure); !ok {
+		t.Error("failed to create s3 file body infrastructure")
+	}
+}
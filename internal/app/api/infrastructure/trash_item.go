@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type trashItemInfrastructure struct{}
+
+func NewTrashItemInfrastructure() repository.TrashItemRepository {
+	return &trashItemInfrastructure{}
+}
+
+func (ti *trashItemInfrastructure) Create(db *gorm.DB, trashItem *entity.TrashItem) (*entity.TrashItem, error) {
+	trashItemModel := ti.convertToModel(trashItem)
+	if err := db.Create(trashItemModel).Error; err != nil {
+		return nil, err
+	}
+	return ti.convertToEntity(trashItemModel), nil
+}
+
+func (ti *trashItemInfrastructure) Remove(db *gorm.DB, trashItem *entity.TrashItem) error {
+	trashItemModel := ti.convertToModel(trashItem)
+	return db.Delete(trashItemModel).Error
+}
+
+func (ti *trashItemInfrastructure) FindAllByUserID(db *gorm.DB, userID uint64) ([]entity.TrashItem, error) {
+	var trashItemModels []model.TrashItemModel
+	if err := db.Order("id").Find(&trashItemModels, "user_id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+	return ti.convertToEntities(trashItemModels), nil
+}
+
+func (ti *trashItemInfrastructure) FindAllByParentFolderIDs(db *gorm.DB, parentFolderIDs []uint64) ([]entity.TrashItem, error) {
+	var trashItemModels []model.TrashItemModel
+	if err := db.Order("id").Find(&trashItemModels, "parent_folder_id IN ?", parentFolderIDs).Error; err != nil {
+		return nil, err
+	}
+	return ti.convertToEntities(trashItemModels), nil
+}
+
+func (ti *trashItemInfrastructure) FindAllByCreatedAtBefore(db *gorm.DB, before time.Time) ([]entity.TrashItem, error) {
+	var trashItemModels []model.TrashItemModel
+	if err := db.Order("id").Find(&trashItemModels, "created_at < ?", before).Error; err != nil {
+		return nil, err
+	}
+	return ti.convertToEntities(trashItemModels), nil
+}
+
+func (ti *trashItemInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.TrashItem, error) {
+	var trashItemModel model.TrashItemModel
+	if err := db.First(&trashItemModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ti.convertToEntity(&trashItemModel), nil
+}
+
+func (ti *trashItemInfrastructure) convertToModel(trashItem *entity.TrashItem) *model.TrashItemModel {
+	return &model.TrashItemModel{
+		ID:             trashItem.ID,
+		UserID:         trashItem.UserID,
+		FolderID:       trashItem.FolderID,
+		FileID:         trashItem.FileID,
+		ParentFolderID: trashItem.ParentFolderID,
+		Name:           trashItem.Name,
+		Path:           trashItem.Path,
+		CreatedAt:      trashItem.CreatedAt,
+	}
+}
+
+func (ti *trashItemInfrastructure) convertToEntity(trashItem *model.TrashItemModel) *entity.TrashItem {
+	return &entity.TrashItem{
+		ID:             trashItem.ID,
+		UserID:         trashItem.UserID,
+		FolderID:       trashItem.FolderID,
+		FileID:         trashItem.FileID,
+		ParentFolderID: trashItem.ParentFolderID,
+		Name:           trashItem.Name,
+		Path:           trashItem.Path,
+		CreatedAt:      trashItem.CreatedAt,
+	}
+}
+
+func (ti *trashItemInfrastructure) convertToEntities(trashItems []model.TrashItemModel) []entity.TrashItem {
+	entities := make([]entity.TrashItem, len(trashItems))
+	for i, v := range trashItems {
+		entities[i] = *ti.convertToEntity(&v)
+	}
+	return entities
+}
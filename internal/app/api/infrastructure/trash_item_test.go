@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateTrashItem(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	file, err := entity.NewFileInfo(2, "name.txt", "/path/name.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file.ID = 3
+	trashItem := entity.NewFileTrashItem(1, file)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `trash_items` (`user_id`,`folder_id`,`file_id`,`parent_folder_id`,`name`,`path`,`created_at`) VALUES (?,?,?,?,?,?,?)")).WithArgs(1, nil, 3, 2, "name.txt", "/path/name.txt", database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ti := NewTrashItemInfrastructure()
+
+	result, err := ti.Create(db, trashItem)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.TrashPath() != "/:trash/1/name.txt" {
+		t.Error("failed to create trash item")
+	}
+}
+
+func TestFindAllTrashItemsByCreatedAtBefore(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	before := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `trash_items` WHERE created_at < ? ORDER BY id")).WithArgs(before).WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "folder_id", "file_id", "parent_folder_id", "name", "path", "created_at"}).AddRow(1, 1, 2, nil, 1, "name", "/name/", before.Add(-time.Hour)))
+
+	ti := NewTrashItemInfrastructure()
+
+	result, err := ti.FindAllByCreatedAtBefore(db, before)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || !result[0].IsFolder() || result[0].TrashPath() != "/:trash/1/name/" {
+		t.Error("failed to find expired trash items")
+	}
+}
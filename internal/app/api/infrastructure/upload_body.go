@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/pkg/types"
+	"io"
+	"os"
+)
+
+type uploadBodyInfrastructure struct {
+	storage types.Storage
+}
+
+func NewUploadBodyInfrastructure(storage types.Storage) repository.UploadBodyRepository {
+	return &uploadBodyInfrastructure{
+		storage: storage,
+	}
+}
+
+func (ui *uploadBodyInfrastructure) Create(id string) error {
+	if err := os.MkdirAll(ui.storage.Path, ui.storage.DirMode); err != nil {
+		return storageError(err)
+	}
+
+	f, err := os.OpenFile(ui.storage.Path+"/"+id, os.O_WRONLY|os.O_CREATE|os.O_EXCL, ui.storage.FileMode)
+	if err != nil {
+		return storageError(err)
+	}
+	return storageError(f.Close())
+}
+
+func (ui *uploadBodyInfrastructure) Write(id string, offset int64, body io.Reader) (int64, error) {
+	f, err := os.OpenFile(ui.storage.Path+"/"+id, os.O_WRONLY, 0)
+	if err != nil {
+		return 0, storageError(err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return 0, storageError(err)
+	}
+	n, err := io.Copy(f, body)
+	if err != nil {
+		return n, storageError(err)
+	}
+	return n, storageError(f.Sync())
+}
+
+func (ui *uploadBodyInfrastructure) Remove(id string) error {
+	if err := os.Remove(ui.storage.Path + "/" + id); err != nil && !os.IsNotExist(err) {
+		return storageError(err)
+	}
+	return nil
+}
+
+func (ui *uploadBodyInfrastructure) Read(id string) (io.ReadCloser, error) {
+	f, err := os.Open(ui.storage.Path + "/" + id)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return f, nil
+}
@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type uploadSessionInfrastructure struct{}
+
+func NewUploadSessionInfrastructure() repository.UploadSessionRepository {
+	return &uploadSessionInfrastructure{}
+}
+
+func (ui *uploadSessionInfrastructure) Create(db *gorm.DB, upload *entity.UploadSession) (*entity.UploadSession, error) {
+	uploadModel := ui.convertToModel(upload)
+	if err := db.Create(uploadModel).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(uploadModel)
+}
+
+func (ui *uploadSessionInfrastructure) Update(db *gorm.DB, upload *entity.UploadSession) (*entity.UploadSession, error) {
+	uploadModel := ui.convertToModel(upload)
+	if err := db.Save(uploadModel).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(uploadModel)
+}
+
+func (ui *uploadSessionInfrastructure) Remove(db *gorm.DB, upload *entity.UploadSession) error {
+	uploadModel := ui.convertToModel(upload)
+	return db.Delete(uploadModel).Error
+}
+
+func (ui *uploadSessionInfrastructure) FindOneByID(db *gorm.DB, id string) (*entity.UploadSession, error) {
+	var uploadModel model.UploadSessionModel
+	if err := db.First(&uploadModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(&uploadModel)
+}
+
+func (ui *uploadSessionInfrastructure) FindOneByIDWithLock(db *gorm.DB, id string) (*entity.UploadSession, error) {
+	var uploadModel model.UploadSessionModel
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&uploadModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(&uploadModel)
+}
+
+func (ui *uploadSessionInfrastructure) FindByUpdatedAtBefore(db *gorm.DB, updatedAt time.Time) ([]entity.UploadSession, error) {
+	var uploadModels []model.UploadSessionModel
+	if err := db.Find(&uploadModels, "updated_at < ?", updatedAt).Error; err != nil {
+		return nil, err
+	}
+	uploads := make([]entity.UploadSession, len(uploadModels))
+	for i, v := range uploadModels {
+		upload, err := ui.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		uploads[i] = *upload
+	}
+	return uploads, nil
+}
+
+func (ui *uploadSessionInfrastructure) convertToModel(upload *entity.UploadSession) *model.UploadSessionModel {
+	return &model.UploadSessionModel{
+		ID:        upload.ID,
+		UserID:    upload.UserID,
+		FolderID:  upload.FolderID,
+		Name:      upload.Name.Value,
+		Size:      upload.Size,
+		Offset:    upload.Offset,
+		IsHide:    upload.IsHide,
+		CreatedAt: upload.CreatedAt,
+		UpdatedAt: upload.UpdatedAt,
+	}
+}
+
+func (ui *uploadSessionInfrastructure) convertToEntity(upload *model.UploadSessionModel) (*entity.UploadSession, error) {
+	uploadEntity := &entity.UploadSession{}
+	uploadEntity.ID = upload.ID
+	uploadEntity.UserID = upload.UserID
+	uploadEntity.FolderID = upload.FolderID
+	if err := uploadEntity.SetName(upload.Name); err != nil {
+		return nil, err
+	}
+	uploadEntity.Size = upload.Size
+	uploadEntity.Offset = upload.Offset
+	uploadEntity.IsHide = upload.IsHide
+	uploadEntity.CreatedAt = upload.CreatedAt
+	uploadEntity.UpdatedAt = upload.UpdatedAt
+	return uploadEntity, nil
+}
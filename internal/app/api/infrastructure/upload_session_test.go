@@ -0,0 +1,191 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCreateUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `upload_sessions` (`id`,`user_id`,`folder_id`,`name`,`size`,`offset`,`is_hide`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?)")).WithArgs(uploadSession.ID, uploadSession.UserID, uploadSession.FolderID, uploadSession.Name.Value, uploadSession.Size, uploadSession.Offset, uploadSession.IsHide, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ui := NewUploadSessionInfrastructure()
+
+	result, err := ui.Create(db, uploadSession)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(entity.UploadSession{}, "CreatedAt", "UpdatedAt"),
+	}
+
+	if diff := cmp.Diff(uploadSession, result, opts...); diff != "" {
+		t.Error(diff)
+	}
+
+	if result.CreatedAt.IsZero() {
+		t.Error("failed to insert created_at automatically")
+	}
+
+	if result.UpdatedAt.IsZero() {
+		t.Error("failed to insert updated_at automatically")
+	}
+}
+
+func TestUpdateUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	uploadSession.Offset = 2
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `upload_sessions` SET `user_id`=?,`folder_id`=?,`name`=?,`size`=?,`offset`=?,`is_hide`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")).WithArgs(uploadSession.UserID, uploadSession.FolderID, uploadSession.Name.Value, uploadSession.Size, uploadSession.Offset, uploadSession.IsHide, database.AnyTime{}, database.AnyTime{}, uploadSession.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ui := NewUploadSessionInfrastructure()
+
+	result, err := ui.Update(db, uploadSession)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(entity.UploadSession{}, "UpdatedAt"),
+	}
+
+	if diff := cmp.Diff(uploadSession, result, opts...); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRemoveUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload_sessions` WHERE `upload_sessions`.`id` = ?")).WithArgs(uploadSession.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ui := NewUploadSessionInfrastructure()
+
+	err = ui.Remove(db, uploadSession)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestFindOneUploadSessionByID(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `upload_sessions` WHERE id = ? ORDER BY `upload_sessions`.`id` LIMIT ?")).WithArgs("id", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "size", "offset", "is_hide", "created_at", "updated_at"}).AddRow("id", 1, "name", 4, 0, false, time.Now(), time.Now()))
+
+	ui := NewUploadSessionInfrastructure()
+
+	result, err := ui.FindOneByID(db, "id")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil {
+		t.Error("failed to find the upload session by id")
+	}
+}
+
+func TestFindOneUploadSessionByIDWithLock(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `upload_sessions` WHERE id = ? ORDER BY `upload_sessions`.`id` LIMIT ? FOR UPDATE")).WithArgs("id", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "size", "offset", "is_hide", "created_at", "updated_at"}).AddRow("id", 1, "name", 4, 0, false, time.Now(), time.Now()))
+
+	ui := NewUploadSessionInfrastructure()
+
+	result, err := ui.FindOneByIDWithLock(db, "id")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil {
+		t.Error("failed to find the upload session by id with lock")
+	}
+}
+
+func TestFindUploadSessionsByUpdatedAtBefore(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `upload_sessions` WHERE updated_at < ?")).WithArgs(database.AnyTime{}).WillReturnRows(sqlmock.NewRows([]string{"id", "folder_id", "name", "size", "offset", "is_hide", "created_at", "updated_at"}).AddRow("id", 1, "name", 4, 0, false, time.Now(), time.Now()))
+
+	ui := NewUploadSessionInfrastructure()
+
+	results, err := ui.FindByUpdatedAtBefore(db, time.Now())
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(results) != 1 {
+		t.Error("failed to find the upload sessions by updated_at")
+	}
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/infrastructure/model"
+
+	"gorm.io/gorm"
+)
+
+type userInfrastructure struct{}
+
+func NewUserInfrastructure() repository.UserRepository {
+	return &userInfrastructure{}
+}
+
+func (ui *userInfrastructure) Create(db *gorm.DB, user *entity.User) (*entity.User, error) {
+	userModel := ui.convertToModel(user)
+	if err := db.Create(userModel).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(userModel)
+}
+
+func (ui *userInfrastructure) Update(db *gorm.DB, user *entity.User) (*entity.User, error) {
+	userModel := ui.convertToModel(user)
+	if err := db.Save(userModel).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(userModel)
+}
+
+func (ui *userInfrastructure) Remove(db *gorm.DB, user *entity.User) error {
+	userModel := ui.convertToModel(user)
+	return db.Delete(userModel).Error
+}
+
+func (ui *userInfrastructure) FindAll(db *gorm.DB) ([]entity.User, error) {
+	var userModels []model.UserModel
+	if err := db.Order("id").Find(&userModels).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]entity.User, len(userModels))
+	for i, v := range userModels {
+		user, err := ui.convertToEntity(&v)
+		if err != nil {
+			return nil, err
+		}
+		users[i] = *user
+	}
+	return users, nil
+}
+
+func (ui *userInfrastructure) FindOneByID(db *gorm.DB, id uint64) (*entity.User, error) {
+	var userModel model.UserModel
+	if err := db.First(&userModel, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(&userModel)
+}
+
+func (ui *userInfrastructure) FindOneByName(db *gorm.DB, name string) (*entity.User, error) {
+	var userModel model.UserModel
+	if err := db.First(&userModel, "name = ?", name).Error; err != nil {
+		return nil, err
+	}
+	return ui.convertToEntity(&userModel)
+}
+
+func (ui *userInfrastructure) convertToModel(user *entity.User) *model.UserModel {
+	return &model.UserModel{
+		ID:               user.ID,
+		Name:             user.Name.Value,
+		Password:         user.Password,
+		IsAdmin:          user.IsAdmin,
+		IsDisabled:       user.IsDisabled,
+		TokensValidAfter: user.TokensValidAfter,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
+	}
+}
+
+func (ui *userInfrastructure) convertToEntity(user *model.UserModel) (*entity.User, error) {
+	userEntity := &entity.User{}
+	userEntity.ID = user.ID
+	if err := userEntity.SetName(user.Name); err != nil {
+		return nil, err
+	}
+	userEntity.Password = user.Password
+	userEntity.IsAdmin = user.IsAdmin
+	userEntity.IsDisabled = user.IsDisabled
+	userEntity.TokensValidAfter = user.TokensValidAfter
+	userEntity.CreatedAt = user.CreatedAt
+	userEntity.UpdatedAt = user.UpdatedAt
+	return userEntity, nil
+}
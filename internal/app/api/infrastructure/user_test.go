@@ -0,0 +1,90 @@
+package infrastructure
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCreateUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`password`,`is_admin`,`is_disabled`,`tokens_valid_after`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?)")).WithArgs("name", user.Password, true, false, nil, database.AnyTime{}, database.AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ui := NewUserInfrastructure()
+
+	result, err := ui.Create(db, user)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Name.Value != "name" || !result.IsAdmin {
+		t.Error("failed to create user")
+	}
+}
+
+func TestFindAllUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` ORDER BY id")).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "is_admin", "is_disabled", "created_at", "updated_at"}).AddRow(1, "admin", "hash", true, false, time.Now(), time.Now()).AddRow(2, "user", "hash", false, true, time.Now(), time.Now()))
+
+	ui := NewUserInfrastructure()
+
+	result, err := ui.FindAll(db)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[1].Name.Value != "user" || !result[1].IsDisabled {
+		t.Error("failed to find users")
+	}
+}
+
+func TestFindOneUserByName(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE name = ? ORDER BY `users`.`id` LIMIT ?")).WithArgs("name", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "password", "is_admin", "is_disabled", "created_at", "updated_at"}).AddRow(1, "name", "hash", false, false, time.Now(), time.Now()))
+
+	ui := NewUserInfrastructure()
+
+	result, err := ui.FindOneByName(db, "name")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.ID != 1 {
+		t.Error("failed to find the user")
+	}
+}
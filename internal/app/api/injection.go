@@ -1,49 +1,130 @@
 package api
 
 import (
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
 	"file-server/internal/app/api/domain/service"
 	"file-server/internal/app/api/infrastructure"
 	"file-server/internal/app/api/interface/handler"
 	"file-server/internal/app/api/usecase"
+	"file-server/internal/pkg/config"
+	"file-server/internal/pkg/types"
 
 	"gorm.io/gorm"
 )
 
 var (
-	credentialRepository repository.CredentialRepository
-	folderInfoRepository repository.FolderInfoRepository
-	folderBodyRepository repository.FolderBodyRepository
-	fileInfoRepository   repository.FileInfoRepository
-	fileBodyRepository   repository.FileBodyRepository
-
-	folderInfoService service.FolderInfoService
-	fileInfoService   service.FileInfoService
-
-	authUsecase   usecase.AuthUsecase
-	folderUsecase usecase.FolderUsecase
-	fileUsecase   usecase.FileUsecase
-
-	authHandler   handler.AuthHandler
-	folderHandler handler.FolderHandler
-	fileHandler   handler.FileHandler
+	authPolicy entity.AuthPolicy
+
+	userRepository          repository.UserRepository
+	revokedTokenRepository  repository.RevokedTokenRepository
+	apiKeyRepository        repository.APIKeyRepository
+	shareLinkRepository     repository.ShareLinkRepository
+	trashItemRepository     repository.TrashItemRepository
+	accessControlRepository repository.AccessControlRepository
+	folderInfoRepository    repository.FolderInfoRepository
+	folderBodyRepository    repository.FolderBodyRepository
+	fileInfoRepository      repository.FileInfoRepository
+	fileBodyRepository      repository.FileBodyRepository
+	fileVersionRepository   repository.FileVersionRepository
+	uploadSessionRepository repository.UploadSessionRepository
+	uploadBodyRepository    repository.UploadBodyRepository
+	journalRepository       repository.JournalRepository
+
+	userService          service.UserService
+	folderInfoService    service.FolderInfoService
+	fileInfoService      service.FileInfoService
+	accessControlService service.AccessControlService
+	trashService         service.TrashService
+	fileVersionService   service.FileVersionService
+	unitOfWork           service.UnitOfWork
+
+	authUsecase          usecase.AuthUsecase
+	userUsecase          usecase.UserUsecase
+	apiKeyUsecase        usecase.APIKeyUsecase
+	shareLinkUsecase     usecase.ShareLinkUsecase
+	trashUsecase         usecase.TrashUsecase
+	accessControlUsecase usecase.AccessControlUsecase
+	folderUsecase        usecase.FolderUsecase
+	fileUsecase          usecase.FileUsecase
+	fileVersionUsecase   usecase.FileVersionUsecase
+	uploadSessionUsecase usecase.UploadSessionUsecase
+	checkUsecase         usecase.CheckUsecase
+
+	authHandler          handler.AuthHandler
+	userHandler          handler.UserHandler
+	apiKeyHandler        handler.APIKeyHandler
+	shareLinkHandler     handler.ShareLinkHandler
+	trashHandler         handler.TrashHandler
+	accessControlHandler handler.AccessControlHandler
+	folderHandler        handler.FolderHandler
+	fileHandler          handler.FileHandler
+	fileVersionHandler   handler.FileVersionHandler
+	uploadSessionHandler handler.UploadSessionHandler
+	checkHandler         handler.CheckHandler
 )
 
-func inject(db *gorm.DB) {
-	credentialRepository = infrastructure.NewCredentialInfrastructure()
+func inject(db *gorm.DB) error {
+	policy, err := entity.NewAuthPolicy(config.AUTH_POLICY)
+	if err != nil {
+		return err
+	}
+	authPolicy = policy
+
+	userRepository = infrastructure.NewUserInfrastructure()
+	revokedTokenRepository = infrastructure.NewRevokedTokenInfrastructure()
+	apiKeyRepository = infrastructure.NewAPIKeyInfrastructure()
+	shareLinkRepository = infrastructure.NewShareLinkInfrastructure()
+	trashItemRepository = infrastructure.NewTrashItemInfrastructure()
+	accessControlRepository = infrastructure.NewAccessControlInfrastructure()
 	folderInfoRepository = infrastructure.NewFolderInfoInfrastructure()
-	folderBodyRepository = infrastructure.NewFolderBodyInfrastructure()
 	fileInfoRepository = infrastructure.NewFileInfoInfrastructure()
-	fileBodyRepository = infrastructure.NewFileBodyInfrastructure()
+	fileVersionRepository = infrastructure.NewFileVersionInfrastructure()
+	folderBodyRepository, fileBodyRepository, err = infrastructure.NewBodyInfrastructure(db)
+	if err != nil {
+		return err
+	}
+	journalRepository = infrastructure.NewJournalInfrastructure()
+	unitOfWork = service.NewUnitOfWork(journalRepository, folderBodyRepository, fileBodyRepository)
+	folderBodyRepository = service.NewJournalFolderBodyRepository(journalRepository, folderBodyRepository)
+	fileBodyRepository = service.NewJournalFileBodyRepository(journalRepository, fileBodyRepository)
+	uploadSessionRepository = infrastructure.NewUploadSessionInfrastructure()
+	uploadBodyRepository = infrastructure.NewUploadBodyInfrastructure(types.Storage{
+		Path:     config.UPLOAD_PATH,
+		FileMode: config.STORAGE_FILE_MODE,
+		DirMode:  config.STORAGE_DIR_MODE,
+	})
 
+	userService = service.NewUserService(userRepository)
 	folderInfoService = service.NewFolderInfoService(folderInfoRepository)
 	fileInfoService = service.NewFileInfoService(fileInfoRepository)
+	accessControlService = service.NewAccessControlService(accessControlRepository, folderInfoRepository, userRepository, authPolicy)
+	trashService = service.NewTrashService(trashItemRepository, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository)
+	fileVersionService = service.NewFileVersionService(fileVersionRepository, folderBodyRepository, fileBodyRepository, config.FILE_VERSION_LIMIT)
 
-	authUsecase = usecase.NewAuthUsecase(db, credentialRepository)
-	folderUsecase = usecase.NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
-	fileUsecase = usecase.NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	authUsecase = usecase.NewAuthUsecase(db, userRepository, revokedTokenRepository)
+	userUsecase = usecase.NewUserUsecase(db, userRepository, userService)
+	apiKeyUsecase = usecase.NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+	shareLinkUsecase = usecase.NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+	trashUsecase = usecase.NewTrashUsecase(db, trashItemRepository, folderInfoRepository, fileInfoRepository, trashService, accessControlService, unitOfWork)
+	accessControlUsecase = usecase.NewAccessControlUsecase(db, accessControlRepository, folderInfoRepository, userRepository, accessControlService)
+	folderUsecase = usecase.NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+	fileUsecase = usecase.NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+	fileVersionUsecase = usecase.NewFileVersionUsecase(db, fileVersionRepository, fileInfoRepository, fileBodyRepository, fileVersionService, accessControlService, unitOfWork)
+	uploadSessionUsecase = usecase.NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+	checkUsecase = usecase.NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
 
 	authHandler = handler.NewAuthHandler(authUsecase)
+	userHandler = handler.NewUserHandler(userUsecase)
+	apiKeyHandler = handler.NewAPIKeyHandler(apiKeyUsecase)
+	accessControlHandler = handler.NewAccessControlHandler(accessControlUsecase)
+	trashHandler = handler.NewTrashHandler(trashUsecase)
+	shareLinkHandler = handler.NewShareLinkHandler(shareLinkUsecase, fileUsecase, folderUsecase)
 	folderHandler = handler.NewFolderHandler(folderUsecase)
 	fileHandler = handler.NewFileHandler(fileUsecase)
+	fileVersionHandler = handler.NewFileVersionHandler(fileVersionUsecase)
+	uploadSessionHandler = handler.NewUploadSessionHandler(uploadSessionUsecase)
+	checkHandler = handler.NewCheckHandler(checkUsecase)
+
+	return nil
 }
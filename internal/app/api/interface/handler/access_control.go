@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccessControlHandler interface {
+	FindAll(*gin.Context)
+	Grant(*gin.Context)
+	Revoke(*gin.Context)
+}
+
+type accessControlHandler struct {
+	usecase usecase.AccessControlUsecase
+}
+
+func NewAccessControlHandler(usecase usecase.AccessControlUsecase) AccessControlHandler {
+	return &accessControlHandler{
+		usecase: usecase,
+	}
+}
+
+func (ah *accessControlHandler) FindAll(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dtos, err := ah.usecase.FindAll(id, ah.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.AccessControlResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *ah.convertToAccessControlResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (ah *accessControlHandler) Grant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	var request requests.GrantAccessControlRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := ah.usecase.Grant(id, userID, request.Permission, ah.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ah.convertToAccessControlResponse(dto))
+}
+
+func (ah *accessControlHandler) Revoke(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	if err := ah.usecase.Revoke(id, userID, ah.getPrincipal(c)); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ah *accessControlHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (ah *accessControlHandler) convertToAccessControlResponse(entry *dto.AccessControlDTO) *responses.AccessControlResponse {
+	return responses.NewAccessControlResponse(entry.ID, entry.FolderID, entry.UserID, entry.Permission, entry.CreatedAt, entry.UpdatedAt)
+}
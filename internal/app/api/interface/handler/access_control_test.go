@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestFindAllAccessControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/folders/1/acl", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dtos := []dto.AccessControlDTO{*dto.NewAccessControlDTO(1, 1, 2, "read", time.Now(), time.Now())}
+
+	au := mock_usecase.NewMockAccessControlUsecase(ctrl)
+	au.EXPECT().FindAll(uint64(1), entity.Principal{UserID: 1}).Return(dtos, nil)
+
+	ah := NewAccessControlHandler(au)
+
+	ah.FindAll(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestGrantAccessControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.GrantAccessControlRequest{
+		Permission: "write",
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("PUT", "/folders/1/acl/2", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Params = append(ctx.Params, gin.Param{Key: "user_id", Value: strconv.Itoa(2)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewAccessControlDTO(1, 1, 2, "write", time.Now(), time.Now())
+
+	au := mock_usecase.NewMockAccessControlUsecase(ctrl)
+	au.EXPECT().Grant(uint64(1), uint64(2), "write", entity.Principal{UserID: 1}).Return(dto, nil)
+
+	ah := NewAccessControlHandler(au)
+
+	ah.Grant(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRevokeAccessControlWithoutPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("DELETE", "/folders/1/acl/2", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Params = append(ctx.Params, gin.Param{Key: "user_id", Value: strconv.Itoa(2)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAccessControlUsecase(ctrl)
+	au.EXPECT().Revoke(uint64(1), uint64(2), entity.Principal{}).Return(entity.ErrPermissionDenied)
+
+	ah := NewAccessControlHandler(au)
+
+	ah.Revoke(ctx)
+
+	if ctx.Writer.Status() != http.StatusForbidden {
+		t.Error(w.Body.String())
+	}
+}
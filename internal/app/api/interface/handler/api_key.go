@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler interface {
+	Create(*gin.Context)
+	FindAll(*gin.Context)
+	Remove(*gin.Context)
+}
+
+type apiKeyHandler struct {
+	usecase usecase.APIKeyUsecase
+}
+
+func NewAPIKeyHandler(usecase usecase.APIKeyUsecase) APIKeyHandler {
+	return &apiKeyHandler{
+		usecase: usecase,
+	}
+}
+
+func (ah *apiKeyHandler) Create(c *gin.Context) {
+	var request requests.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := ah.usecase.Create(request.Name, request.Scope, request.FolderID, request.ExpiresAt, ah.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ah.convertToAPIKeyResponse(dto))
+}
+
+func (ah *apiKeyHandler) FindAll(c *gin.Context) {
+	dtos, err := ah.usecase.FindAll(ah.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.APIKeyResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *ah.convertToAPIKeyResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (ah *apiKeyHandler) Remove(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	if err := ah.usecase.Remove(id, ah.getPrincipal(c)); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ah *apiKeyHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (ah *apiKeyHandler) convertToAPIKeyResponse(apiKey *dto.APIKeyDTO) *responses.APIKeyResponse {
+	return responses.NewAPIKeyResponse(apiKey.ID, apiKey.Name, apiKey.Key, apiKey.Scope, apiKey.FolderID, apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.CreatedAt, apiKey.UpdatedAt)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	input := requests.CreateAPIKeyRequest{
+		Name:      "backup",
+		Scope:     "read",
+		ExpiresAt: expiresAt,
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/api-keys", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewAPIKeyDTO(1, 1, "backup", "fsk_prefix_secret", "read", nil, expiresAt, nil, time.Now(), time.Now())
+
+	au := mock_usecase.NewMockAPIKeyUsecase(ctrl)
+	au.EXPECT().Create("backup", "read", nil, gomock.Any(), entity.Principal{UserID: 1}).Return(dto, nil)
+
+	ah := NewAPIKeyHandler(au)
+
+	ah.Create(ctx)
+
+	if w.Code != http.StatusCreated {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRemoveAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("DELETE", "/api-keys/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAPIKeyUsecase(ctrl)
+	au.EXPECT().Remove(uint64(1), entity.Principal{UserID: 1}).Return(gorm.ErrRecordNotFound)
+
+	ah := NewAPIKeyHandler(au)
+
+	ah.Remove(ctx)
+
+	if ctx.Writer.Status() != http.StatusNotFound {
+		t.Error(w.Body.String())
+	}
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"errors"
 	"file-server/internal/app/api/interface/requests"
 	"file-server/internal/app/api/interface/responses"
 	"file-server/internal/app/api/usecase"
@@ -9,11 +8,12 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 type AuthHandler interface {
 	Signin(*gin.Context)
+	Refresh(*gin.Context)
+	Signout(*gin.Context)
 }
 
 type authHandler struct {
@@ -29,25 +29,56 @@ func NewAuthHandler(usecase usecase.AuthUsecase) AuthHandler {
 func (ah *authHandler) Signin(c *gin.Context) {
 	var request requests.SigninRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := ah.usecase.Signin(request.Password)
+	dto, err := ah.usecase.Signin(request.Name, request.Password)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ah.dtoToResponse(dto))
+}
+
+func (ah *authHandler) Refresh(c *gin.Context) {
+	var request requests.RefreshRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := ah.usecase.Refresh(request.RefreshToken)
+	if err != nil {
+		HandleError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, ah.dtoToResponse(dto))
 }
 
+func (ah *authHandler) Signout(c *gin.Context) {
+	var request requests.SignoutRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			handleBadRequest(c, err)
+			return
+		}
+	}
+
+	if err := ah.usecase.Signout(c.GetString("token"), request.RefreshToken); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 func (ah *authHandler) dtoToResponse(auth *dto.AuthDTO) *responses.AuthResponse {
 	return &responses.AuthResponse{
-		Token: auth.Token,
+		Token:        auth.Token,
+		RefreshToken: auth.RefreshToken,
+		ExpiresIn:    int64(auth.ExpiresIn.Seconds()),
 	}
 }
@@ -3,12 +3,14 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/interface/requests"
 	"file-server/internal/app/api/usecase/dto"
 	mock_usecase "file-server/test/mock/usecase"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
@@ -18,6 +20,7 @@ func TestSignin(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	input := requests.SigninRequest{
+		Name:     "name",
 		Password: "password",
 	}
 
@@ -38,10 +41,10 @@ func TestSignin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewAuthDTO("token")
+	dto := dto.NewAuthDTO("token", "refresh token", time.Hour)
 
 	au := mock_usecase.NewMockAuthUsecase(ctrl)
-	au.EXPECT().Signin(gomock.Any()).Return(dto, nil)
+	au.EXPECT().Signin("name", "password").Return(dto, nil)
 
 	ah := NewAuthHandler(au)
 
@@ -51,3 +54,113 @@ func TestSignin(t *testing.T) {
 		t.Error(w.Body.String())
 	}
 }
+
+func TestSigninWithInvalidCredential(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.SigninRequest{
+		Name:     "name",
+		Password: "invalid password",
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/auth/signin", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAuthUsecase(ctrl)
+	au.EXPECT().Signin("name", "invalid password").Return(nil, entity.ErrInvalidCredential)
+
+	ah := NewAuthHandler(au)
+
+	ah.Signin(ctx)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.RefreshRequest{
+		RefreshToken: "refresh token",
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/auth/refresh", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAuthUsecase(ctrl)
+	au.EXPECT().Refresh("refresh token").Return(nil, entity.ErrTokenRevoked)
+
+	ah := NewAuthHandler(au)
+
+	ah.Refresh(ctx)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestSignout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.SignoutRequest{
+		RefreshToken: "refresh token",
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/auth/signout", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Set("token", "token")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAuthUsecase(ctrl)
+	au.EXPECT().Signout("token", "refresh token").Return(nil)
+
+	ah := NewAuthHandler(au)
+
+	ah.Signout(ctx)
+
+	if ctx.Writer.Status() != http.StatusNoContent {
+		t.Error(w.Body.String())
+	}
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CheckHandler interface {
+	Check(*gin.Context)
+	Repair(*gin.Context)
+}
+
+type checkHandler struct {
+	usecase usecase.CheckUsecase
+}
+
+func NewCheckHandler(usecase usecase.CheckUsecase) CheckHandler {
+	return &checkHandler{
+		usecase: usecase,
+	}
+}
+
+func (ch *checkHandler) Check(c *gin.Context) {
+	dtos, err := ch.usecase.Check()
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ch.convertToInconsistencyResponses(dtos))
+}
+
+func (ch *checkHandler) Repair(c *gin.Context) {
+	var request requests.RepairRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dtos, err := ch.usecase.Repair(request.DryRun)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ch.convertToInconsistencyResponses(dtos))
+}
+
+func (ch *checkHandler) convertToInconsistencyResponses(dtos []dto.InconsistencyDTO) []responses.InconsistencyResponse {
+	res := make([]responses.InconsistencyResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *responses.NewInconsistencyResponse(v.Kind, v.ID, v.Path, v.ExpectedPath, v.Reason, v.Action, v.Repaired)
+	}
+	return res
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRepair(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/admin/check/repair?dry_run=true", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cu := mock_usecase.NewMockCheckUsecase(ctrl)
+	cu.EXPECT().Repair(true).Return([]dto.InconsistencyDTO{*dto.NewInconsistencyDTO("orphan_body", 0, "/orphan.txt", "", "row not found", "import", false)}, nil)
+
+	ch := NewCheckHandler(cu)
+
+	ch.Repair(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+	if w.Body.String() != `[{"kind":"orphan_body","path":"/orphan.txt","reason":"row not found","action":"import","repaired":false}]` {
+		t.Errorf("unexpected body: %s", w.Body.String())
+	}
+}
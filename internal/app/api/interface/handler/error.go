@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/responses"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func HandleError(c *gin.Context, err error) {
+	status, code := classifyError(err)
+
+	detail := err.Error()
+	if status == http.StatusInternalServerError {
+		log.Println(err)
+		detail = http.StatusText(status)
+	}
+
+	var domainErr *entity.Error
+	if errors.As(err, &domainErr) && domainErr.Code != "" {
+		code = domainErr.Code
+	}
+
+	res := responses.NewProblemResponse(status, http.StatusText(status), detail, c.Request.URL.Path, code)
+
+	var conflictErr *entity.ConflictError
+	if errors.As(err, &conflictErr) {
+		res.Code = "already_exists"
+		res.Path = conflictErr.Path
+		res.FolderID = conflictErr.FolderID
+		res.FileID = conflictErr.FileID
+	}
+
+	if errors.Is(err, entity.ErrSharePasswordRequired) || errors.Is(err, entity.ErrInvalidSharePassword) {
+		c.Header("WWW-Authenticate", `Basic realm="share"`)
+	}
+	c.Header("Content-Type", "application/problem+json")
+	c.JSON(status, res)
+}
+
+func classifyError(err error) (int, string) {
+	switch {
+	case errors.Is(err, entity.ErrConflict):
+		return http.StatusConflict, "conflict"
+	case errors.Is(err, entity.ErrStorageQuotaExceeded):
+		return http.StatusInsufficientStorage, "storage_quota_exceeded"
+	case errors.Is(err, entity.ErrStorage):
+		return http.StatusInternalServerError, "storage_failure"
+	case errors.Is(err, entity.ErrValidation):
+		return http.StatusBadRequest, "invalid_request"
+	case errors.Is(err, entity.ErrUnauthorized):
+		return http.StatusUnauthorized, "unauthorized"
+	case errors.Is(err, entity.ErrForbidden):
+		return http.StatusForbidden, "forbidden"
+	case errors.Is(err, entity.ErrNotFound), errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound, "not_found"
+	case errors.Is(err, entity.ErrGone):
+		return http.StatusGone, "gone"
+	case errors.Is(err, entity.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType, "unsupported_media_type"
+	}
+	return http.StatusInternalServerError, "internal_error"
+}
+
+func handleBadRequest(c *gin.Context, err error) {
+	HandleError(c, entity.NewValidationError("invalid_request", err.Error()))
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/responses"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func TestHandleValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("PUT", "/files/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	HandleError(ctx, entity.NewValidationError("invalid_file_name", "invalid file name"))
+
+	if w.Code != http.StatusBadRequest {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Content-Type") != "application/problem+json" {
+		t.Error("failed to set content type")
+	}
+
+	var res responses.ProblemResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if res.Status != http.StatusBadRequest || res.Code != "invalid_file_name" || res.Detail != "invalid file name" || res.Instance != "/files/1" {
+		t.Error("failed to return problem response")
+	}
+}
+
+func TestHandleNotFoundError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/files/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	HandleError(ctx, gorm.ErrRecordNotFound)
+
+	if w.Code != http.StatusNotFound {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.ProblemResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if res.Code != "not_found" {
+		t.Error("failed to return problem response")
+	}
+}
+
+func TestHandleStorageError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/files/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	HandleError(ctx, fmt.Errorf("%w: %w", entity.ErrStorage, fmt.Errorf("open /data/name: no such file or directory")))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.ProblemResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if res.Code != "storage_failure" || res.Detail != http.StatusText(http.StatusInternalServerError) {
+		t.Error("failed to return problem response")
+	}
+}
+
+func TestHandleStorageQuotaExceededError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/files", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	HandleError(ctx, entity.ErrStorageQuotaExceeded)
+
+	if w.Code != http.StatusInsufficientStorage {
+		t.Error(w.Body.String())
+	}
+}
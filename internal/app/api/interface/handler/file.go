@@ -1,18 +1,16 @@
 package handler
 
 import (
-	"errors"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/interface/requests"
 	"file-server/internal/app/api/interface/responses"
 	"file-server/internal/app/api/usecase"
 	"file-server/internal/app/api/usecase/dto"
 	"file-server/internal/pkg/types"
-	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 type FileHandler interface {
@@ -22,6 +20,8 @@ type FileHandler interface {
 	Move(*gin.Context)
 	Copy(*gin.Context)
 	Read(*gin.Context)
+	Overwrite(*gin.Context)
+	Extract(*gin.Context)
 }
 
 type fileHandler struct {
@@ -37,7 +37,7 @@ func NewFileHandler(usecase usecase.FileUsecase) FileHandler {
 func (fh *fileHandler) Create(c *gin.Context) {
 	form, err := c.MultipartForm()
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
@@ -45,34 +45,31 @@ func (fh *fileHandler) Create(c *gin.Context) {
 	for i, file := range form.File["files[]"] {
 		f, err := file.Open()
 		if err != nil {
-			c.String(http.StatusBadRequest, err.Error())
+			handleBadRequest(c, err)
 			return
 		}
 		defer f.Close()
-		body, err := io.ReadAll(f)
-		if err != nil {
-			c.String(http.StatusBadRequest, err.Error())
-			return
-		}
 		files[i] = types.File{
 			Name: file.Filename,
-			Body: body,
+			Body: f,
 		}
 	}
 
 	var request requests.CreateFileRequest
 	if err := c.Bind(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dtos, err := fh.usecase.Create(request.FolderID, request.IsHide, files)
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dtos, err := fh.usecase.Create(request.FolderID, request.IsHide, files, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
 		return
 	}
 
@@ -88,23 +85,19 @@ func (fh *fileHandler) Create(c *gin.Context) {
 func (fh *fileHandler) Update(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.UpdateFileRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Update(id, request.Name, request.IsHide, fh.getIsDisplayHiddenObject(c))
+	dto, err := fh.usecase.Update(id, request.Name, request.IsHide, fh.getPrincipal(c))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
 		return
 	}
 
@@ -114,16 +107,12 @@ func (fh *fileHandler) Update(c *gin.Context) {
 func (fh *fileHandler) Remove(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	if err := fh.usecase.Remove(id, fh.getIsDisplayHiddenObject(c)); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+	if err := fh.usecase.Remove(id, fh.getPrincipal(c)); err != nil {
+		HandleError(c, err)
 		return
 	}
 
@@ -133,23 +122,30 @@ func (fh *fileHandler) Remove(c *gin.Context) {
 func (fh *fileHandler) Move(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.MoveFileRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Move(id, request.FolderID, fh.getIsDisplayHiddenObject(c))
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Move(id, request.FolderID, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto == nil {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
@@ -159,23 +155,30 @@ func (fh *fileHandler) Move(c *gin.Context) {
 func (fh *fileHandler) Copy(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.CopyFileRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Copy(id, request.FolderID, fh.getIsDisplayHiddenObject(c))
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Copy(id, request.FolderID, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto == nil {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
@@ -185,31 +188,109 @@ func (fh *fileHandler) Copy(c *gin.Context) {
 func (fh *fileHandler) Read(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Read(id, fh.getIsDisplayHiddenObject(c))
+	dto, err := fh.usecase.Read(id, fh.getPrincipal(c))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	if dto.URL != "" {
+		c.Redirect(http.StatusFound, dto.URL)
+		return
+	}
+
+	defer dto.Body.Close()
+
+	c.Header("Content-Type", dto.MimeType)
+	http.ServeContent(c.Writer, c.Request, dto.Name, dto.UpdatedAt, dto.Body)
+}
+
+func (fh *fileHandler) Overwrite(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		handleBadRequest(c, err)
 		return
 	}
+	defer f.Close()
 
-	c.Data(http.StatusOK, dto.MimeType, dto.Body)
+	dto, err := fh.usecase.Overwrite(id, f, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fh.convertToFileResponse(dto))
 }
 
-func (fh *fileHandler) getIsDisplayHiddenObject(c *gin.Context) bool {
-	if v, ok := c.Get("isDisplayHiddenObject"); ok && v == true {
-		return true
-	} else {
-		return false
+func (fh *fileHandler) Extract(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	var request requests.ExtractFileRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	conflict, err := fh.getConflictMode(c)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	dtos, err := fh.usecase.Extract(id, request.FolderID, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.FileResponse, len(dtos))
+	for i, v := range dtos {
+		f := fh.convertToFileResponse(&v)
+		res[i] = *f
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (fh *fileHandler) getConflictMode(c *gin.Context) (entity.ConflictMode, error) {
+	conflict, err := entity.NewConflictMode(c.DefaultQuery("conflict", string(entity.ConflictFail)))
+	if err != nil {
+		return "", err
+	}
+	if conflict == entity.ConflictMerge {
+		return "", entity.NewValidationError("invalid_conflict_mode", "invalid conflict mode")
+	}
+	return conflict, nil
+}
+
+func (fh *fileHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
 	}
+	return entity.Principal{}
 }
 
 func (fh *fileHandler) convertToFileResponse(file *dto.FileInfoDTO) *responses.FileResponse {
-	return responses.NewFileResponse(file.ID, file.FolderID, file.Name, file.Path, file.MimeType, file.IsHide, file.CreatedAt, file.UpdatedAt)
+	return responses.NewFileResponse(file.ID, file.FolderID, file.OwnerID, file.Name, file.Path, file.MimeType, file.Size, file.IsHide, file.CreatedAt, file.UpdatedAt)
 }
@@ -3,13 +3,16 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
 	"file-server/internal/app/api/usecase/dto"
 	mock_usecase "file-server/test/mock/usecase"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -60,10 +63,10 @@ func TestCreateFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dtos := []dto.FileInfoDTO{*dto.NewFileInfoDTO(1, 1, "name", "path/name", "mime/type", false, time.Now(), time.Now())}
+	dtos := []dto.FileInfoDTO{*dto.NewFileInfoDTO(1, 1, nil, "name", "path/name", "mime/type", 4, false, time.Now(), time.Now())}
 
 	fu := mock_usecase.NewMockFileUsecase(ctrl)
-	fu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(dtos, nil)
+	fu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dtos, nil)
 
 	fh := NewFileHandler(fu)
 
@@ -100,7 +103,7 @@ func TestUpdateFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFileInfoDTO(1, 1, "name", "path/name", "mime/type", false, time.Now(), time.Now())
+	dto := dto.NewFileInfoDTO(1, 1, nil, "name", "path/name", "mime/type", 4, false, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFileUsecase(ctrl)
 	fu.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
@@ -167,10 +170,10 @@ func TestMoveFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFileInfoDTO(1, 1, "name", "path/name", "mime/type", false, time.Now(), time.Now())
+	dto := dto.NewFileInfoDTO(1, 1, nil, "name", "path/name", "mime/type", 4, false, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFileUsecase(ctrl)
-	fu.EXPECT().Move(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Move(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFileHandler(fu)
 
@@ -206,10 +209,10 @@ func TestCopyFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFileInfoDTO(1, 1, "name", "path/name", "mime/type", false, time.Now(), time.Now())
+	dto := dto.NewFileInfoDTO(1, 1, nil, "name", "path/name", "mime/type", 4, false, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFileUsecase(ctrl)
-	fu.EXPECT().Copy(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Copy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFileHandler(fu)
 
@@ -220,6 +223,89 @@ func TestCopyFile(t *testing.T) {
 	}
 }
 
+func TestExtractFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.ExtractFileRequest{
+		FolderID: 1,
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/files/1/extract?conflict=rename", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dtos := []dto.FileInfoDTO{*dto.NewFileInfoDTO(2, 1, nil, "name", "/name", "mime/type", 4, false, time.Now(), time.Now())}
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Extract(uint64(1), uint64(1), entity.ConflictRename, gomock.Any()).Return(dtos, nil)
+
+	fh := NewFileHandler(fu)
+
+	fh.Extract(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestOverwriteFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	file, err := writer.CreateFormFile("file", "file")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := file.Write([]byte("file")); err != nil {
+		t.Error(err.Error())
+	}
+
+	writer.Close()
+
+	req, err := http.NewRequest("PUT", "/files/1/body", body)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewFileInfoDTO(1, 1, nil, "name", "path/name", "mime/type", 4, false, time.Now(), time.Now())
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Overwrite(uint64(1), gomock.Any(), gomock.Any()).Return(dto, nil)
+
+	fh := NewFileHandler(fu)
+
+	fh.Overwrite(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
 func TestReadFile(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -236,7 +322,7 @@ func TestReadFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFileBodyDTO("mime/type", []byte("file"))
+	dto := dto.NewFileBodyDTO("name", "mime/type", &readSeekCloser{strings.NewReader("file")}, "", time.Now())
 
 	fu := mock_usecase.NewMockFileUsecase(ctrl)
 	fu.EXPECT().Read(gomock.Any(), gomock.Any()).Return(dto, nil)
@@ -248,4 +334,158 @@ func TestReadFile(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Error(w.Body.String())
 	}
+	if w.Header().Get("Accept-Ranges") != "bytes" {
+		t.Error("accept ranges header is not set")
+	}
+}
+
+func TestReadFileWithRange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/files/1/body", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Set("Range", "bytes=1-2")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewFileBodyDTO("name", "mime/type", &readSeekCloser{strings.NewReader("file")}, "", time.Now())
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Read(gomock.Any(), gomock.Any()).Return(dto, nil)
+
+	fh := NewFileHandler(fu)
+
+	fh.Read(ctx)
+
+	if w.Code != http.StatusPartialContent {
+		t.Error(w.Body.String())
+	}
+	if w.Body.String() != "il" {
+		t.Errorf("unexpected body: %s", w.Body.String())
+	}
+	if w.Header().Get("Content-Range") != "bytes 1-2/4" {
+		t.Errorf("unexpected content range: %s", w.Header().Get("Content-Range"))
+	}
+}
+
+func TestReadFileWithPresignedURL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/files/1/body", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewFileBodyDTO("name", "mime/type", nil, "https://s3.example.com/bucket/name", time.Now())
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Read(gomock.Any(), gomock.Any()).Return(dto, nil)
+
+	fh := NewFileHandler(fu)
+
+	fh.Read(ctx)
+
+	if w.Code != http.StatusFound {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Location") != "https://s3.example.com/bucket/name" {
+		t.Errorf("unexpected location: %s", w.Header().Get("Location"))
+	}
+}
+
+type readSeekCloser struct {
+	*strings.Reader
+}
+
+func (r *readSeekCloser) Close() error {
+	return nil
+}
+
+func TestMoveFileConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.MoveFileRequest{
+		FolderID: 2,
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("PUT", "/files/1/move", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var fileID uint64 = 3
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Move(uint64(1), uint64(2), entity.ConflictFail, gomock.Any()).Return(nil, &entity.ConflictError{Path: "/path/name", FileID: &fileID})
+
+	fh := NewFileHandler(fu)
+
+	fh.Move(ctx)
+
+	if w.Code != http.StatusConflict {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.ProblemResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if res.Code != "already_exists" || res.Path != "/path/name" || res.FileID == nil || *res.FileID != 3 {
+		t.Error("failed to return conflict response")
+	}
+}
+
+func TestMoveFileWithMerge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("PUT", "/files/1/move?conflict=merge", strings.NewReader(`{"folder_id":2}`))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+
+	fh := NewFileHandler(fu)
+
+	fh.Move(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Error(w.Body.String())
+	}
 }
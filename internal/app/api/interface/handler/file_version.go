@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FileVersionHandler interface {
+	FindAll(*gin.Context)
+	Read(*gin.Context)
+	Restore(*gin.Context)
+}
+
+type fileVersionHandler struct {
+	usecase usecase.FileVersionUsecase
+}
+
+func NewFileVersionHandler(usecase usecase.FileVersionUsecase) FileVersionHandler {
+	return &fileVersionHandler{
+		usecase: usecase,
+	}
+}
+
+func (fh *fileVersionHandler) FindAll(c *gin.Context) {
+	fileID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dtos, err := fh.usecase.FindAll(fileID, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.FileVersionResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *fh.convertToFileVersionResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (fh *fileVersionHandler) Read(c *gin.Context) {
+	fileID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("version_id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Read(fileID, id, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto.URL != "" {
+		c.Redirect(http.StatusFound, dto.URL)
+		return
+	}
+
+	defer dto.Body.Close()
+
+	c.Header("Content-Type", dto.MimeType)
+	http.ServeContent(c.Writer, c.Request, dto.Name, dto.UpdatedAt, dto.Body)
+}
+
+func (fh *fileVersionHandler) Restore(c *gin.Context) {
+	fileID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("version_id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Restore(fileID, id, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.NewFileResponse(dto.ID, dto.FolderID, dto.OwnerID, dto.Name, dto.Path, dto.MimeType, dto.Size, dto.IsHide, dto.CreatedAt, dto.UpdatedAt))
+}
+
+func (fh *fileVersionHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (fh *fileVersionHandler) convertToFileVersionResponse(fileVersion *dto.FileVersionDTO) *responses.FileVersionResponse {
+	return responses.NewFileVersionResponse(fileVersion.ID, fileVersion.FileID, fileVersion.MimeType, fileVersion.CreatedAt)
+}
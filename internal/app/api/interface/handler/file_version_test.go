@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestFindAllFileVersions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/files/1/versions", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dtos := []dto.FileVersionDTO{*dto.NewFileVersionDTO(2, 1, "text/plain", time.Now())}
+
+	fu := mock_usecase.NewMockFileVersionUsecase(ctrl)
+	fu.EXPECT().FindAll(uint64(1), entity.Principal{UserID: 1}).Return(dtos, nil)
+
+	fh := NewFileVersionHandler(fu)
+
+	fh.FindAll(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRestoreFileVersionNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/files/1/versions/2/restore", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)}, gin.Param{Key: "version_id", Value: strconv.Itoa(2)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFileVersionUsecase(ctrl)
+	fu.EXPECT().Restore(uint64(1), uint64(2), entity.Principal{UserID: 1}).Return(nil, gorm.ErrRecordNotFound)
+
+	fh := NewFileVersionHandler(fu)
+
+	fh.Restore(ctx)
+
+	if w.Code != http.StatusNotFound {
+		t.Error(w.Body.String())
+	}
+}
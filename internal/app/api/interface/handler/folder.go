@@ -1,16 +1,16 @@
 package handler
 
 import (
-	"errors"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/interface/requests"
 	"file-server/internal/app/api/interface/responses"
 	"file-server/internal/app/api/usecase"
 	"file-server/internal/app/api/usecase/dto"
+	"mime"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 type FolderHandler interface {
@@ -20,7 +20,11 @@ type FolderHandler interface {
 	Move(*gin.Context)
 	Copy(*gin.Context)
 	FindOne(*gin.Context)
+	List(*gin.Context)
+	Count(*gin.Context)
+	Search(*gin.Context)
 	Read(*gin.Context)
+	Archive(*gin.Context)
 }
 
 type folderHandler struct {
@@ -36,17 +40,24 @@ func NewFolderHandler(usecase usecase.FolderUsecase) FolderHandler {
 func (fh *folderHandler) Create(c *gin.Context) {
 	var request requests.CreateFolderRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Create(request.ParentFolderID, request.Name, request.IsHide)
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Create(request.ParentFolderID, request.Name, request.IsHide, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto == nil {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
@@ -56,23 +67,19 @@ func (fh *folderHandler) Create(c *gin.Context) {
 func (fh *folderHandler) Update(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.UpdateFolderRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Update(id, request.Name, request.IsHide, fh.getIsDisplayHiddenObject(c))
+	dto, err := fh.usecase.Update(id, request.Name, request.IsHide, fh.getPrincipal(c))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
 		return
 	}
 
@@ -82,16 +89,12 @@ func (fh *folderHandler) Update(c *gin.Context) {
 func (fh *folderHandler) Remove(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	if err := fh.usecase.Remove(id, fh.getIsDisplayHiddenObject(c)); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+	if err := fh.usecase.Remove(id, fh.getPrincipal(c)); err != nil {
+		HandleError(c, err)
 		return
 	}
 
@@ -101,23 +104,30 @@ func (fh *folderHandler) Remove(c *gin.Context) {
 func (fh *folderHandler) Move(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.MoveFolderRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Move(id, request.ParentFolderID, fh.getIsDisplayHiddenObject(c))
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Move(id, request.ParentFolderID, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto == nil {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
@@ -127,23 +137,30 @@ func (fh *folderHandler) Move(c *gin.Context) {
 func (fh *folderHandler) Copy(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
 	var request requests.CopyFolderRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Copy(id, request.ParentFolderID, fh.getIsDisplayHiddenObject(c))
+	conflict, err := fh.getConflictMode(c)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Copy(id, request.ParentFolderID, conflict, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	if dto == nil {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
@@ -153,45 +170,150 @@ func (fh *folderHandler) Copy(c *gin.Context) {
 func (fh *folderHandler) FindOne(c *gin.Context) {
 	path := c.Param("path")
 
-	dto, err := fh.usecase.FindOne(path, fh.getIsDisplayHiddenObject(c))
+	dto, err := fh.usecase.FindOne(path, fh.getPrincipal(c))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, fh.convertToFolderResponse(dto))
 }
 
+func (fh *folderHandler) List(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	var request requests.ListFolderRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	query, err := entity.NewListQuery(request.Sort, request.Order, request.Cursor, request.Limit, *fh.convertToListFilter(&request.FilterFolderRequest))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.List(id, query, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fh.convertToFolderListResponse(dto))
+}
+
+func (fh *folderHandler) Count(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	var request requests.FilterFolderRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Count(id, fh.convertToListFilter(&request), fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.NewFolderCountResponse(dto.Folders, dto.Files))
+}
+
+func (fh *folderHandler) Search(c *gin.Context) {
+	var request requests.SearchRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	filter := fh.convertToListFilter(&request.FilterFolderRequest)
+	filter.Kind = entity.ListKind(request.Type)
+	filter.Name = request.Query
+	filter.MinSize = request.MinSize
+	filter.MaxSize = request.MaxSize
+	query, err := entity.NewListQuery(request.Sort, request.Order, request.Cursor, request.Limit, *filter)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Search(request.FolderID, query, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fh.convertToFolderListResponse(dto))
+}
+
 func (fh *folderHandler) Read(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
-		c.String(http.StatusBadRequest, err.Error())
+		handleBadRequest(c, err)
 		return
 	}
 
-	dto, err := fh.usecase.Read(id, fh.getIsDisplayHiddenObject(c))
+	dto, err := fh.usecase.Read(c.Request.Context(), id, fh.getPrincipal(c))
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		HandleError(c, err)
 		return
 	}
+	defer dto.Body.Close()
 
-	c.Data(http.StatusOK, dto.MimeType, dto.Body)
+	c.DataFromReader(http.StatusOK, -1, dto.MimeType, dto.Body, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": dto.Name}),
+	})
 }
 
-func (fh *folderHandler) getIsDisplayHiddenObject(c *gin.Context) bool {
-	if v, ok := c.Get("isDisplayHiddenObject"); !ok || v == false {
-		return false
-	} else {
-		return true
+func (fh *folderHandler) Archive(c *gin.Context) {
+	var request requests.ArchiveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+	if request.Format == "" {
+		request.Format = string(entity.ArchiveZip)
 	}
+
+	format, err := entity.NewArchiveFormat(request.Format)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	dto, err := fh.usecase.Archive(c.Request.Context(), request.FolderIDs, request.FileIDs, format, fh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	defer dto.Body.Close()
+
+	c.DataFromReader(http.StatusOK, -1, dto.MimeType, dto.Body, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": dto.Name}),
+	})
+}
+
+func (fh *folderHandler) getConflictMode(c *gin.Context) (entity.ConflictMode, error) {
+	return entity.NewConflictMode(c.DefaultQuery("conflict", string(entity.ConflictFail)))
+}
+
+func (fh *folderHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
 }
 
 func (fh *folderHandler) convertToFolderResponse(folder *dto.FolderInfoDTO) *responses.FolderResponse {
@@ -202,8 +324,36 @@ func (fh *folderHandler) convertToFolderResponse(folder *dto.FolderInfoDTO) *res
 
 	files := make([]responses.FileResponse, len(folder.Files))
 	for i, v := range folder.Files {
-		files[i] = *responses.NewFileResponse(v.ID, v.FolderID, v.Name, v.Path, v.MimeType, v.IsHide, v.CreatedAt, v.UpdatedAt)
+		files[i] = *responses.NewFileResponse(v.ID, v.FolderID, v.OwnerID, v.Name, v.Path, v.MimeType, v.Size, v.IsHide, v.CreatedAt, v.UpdatedAt)
+	}
+
+	return responses.NewFolderResponse(folder.ID, folder.ParentFolderID, folder.OwnerID, folder.Name, folder.Path, folder.IsHide, folders, files, folder.CreatedAt, folder.UpdatedAt)
+}
+
+func (fh *folderHandler) convertToListFilter(request *requests.FilterFolderRequest) *entity.ListFilter {
+	return &entity.ListFilter{
+		MimeType:      request.MimeType,
+		CreatedAfter:  request.CreatedAfter,
+		CreatedBefore: request.CreatedBefore,
+		UpdatedAfter:  request.UpdatedAfter,
+		UpdatedBefore: request.UpdatedBefore,
+	}
+}
+
+func (fh *folderHandler) convertToFolderListResponse(list *dto.FolderListDTO) *responses.FolderListResponse {
+	folders := make([]responses.FolderResponse, len(list.Folders))
+	for i, v := range list.Folders {
+		folders[i] = *fh.convertToFolderResponse(&v)
 	}
 
-	return responses.NewFolderResponse(folder.ID, folder.ParentFolderID, folder.Name, folder.Path, folder.IsHide, folders, files, folder.CreatedAt, folder.UpdatedAt)
+	files := make([]responses.FileResponse, len(list.Files))
+	for i, v := range list.Files {
+		files[i] = *responses.NewFileResponse(v.ID, v.FolderID, v.OwnerID, v.Name, v.Path, v.MimeType, v.Size, v.IsHide, v.CreatedAt, v.UpdatedAt)
+	}
+
+	var nextCursor *string
+	if list.NextCursor != "" {
+		nextCursor = &list.NextCursor
+	}
+	return responses.NewFolderListResponse(folders, files, nextCursor)
 }
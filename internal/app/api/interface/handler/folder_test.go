@@ -3,12 +3,16 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
 	"file-server/internal/app/api/usecase/dto"
 	mock_usecase "file-server/test/mock/usecase"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -42,10 +46,10 @@ func TestCreateFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderInfoDTO(1, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
+	dto := dto.NewFolderInfoDTO(1, nil, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
-	fu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFolderHandler(fu)
 
@@ -82,7 +86,7 @@ func TestUpdateFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderInfoDTO(1, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
+	dto := dto.NewFolderInfoDTO(1, nil, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
 	fu.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
@@ -149,10 +153,10 @@ func TestMoveFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderInfoDTO(1, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
+	dto := dto.NewFolderInfoDTO(1, nil, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
-	fu.EXPECT().Move(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Move(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFolderHandler(fu)
 
@@ -188,10 +192,10 @@ func TestCopyFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderInfoDTO(1, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
+	dto := dto.NewFolderInfoDTO(1, nil, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
-	fu.EXPECT().Copy(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Copy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFolderHandler(fu)
 
@@ -218,7 +222,7 @@ func TestFindOneFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderInfoDTO(1, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
+	dto := dto.NewFolderInfoDTO(1, nil, nil, "name", "/path/name/", false, nil, nil, time.Now(), time.Now())
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
 	fu.EXPECT().FindOne(gomock.Any(), gomock.Any()).Return(dto, nil)
@@ -248,10 +252,10 @@ func TestReadFolder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	dto := dto.NewFolderBodyDTO("mime/type", []byte("folder"))
+	dto := dto.NewFolderBodyDTO("name.zip", "mime/type", io.NopCloser(strings.NewReader("folder")))
 
 	fu := mock_usecase.NewMockFolderUsecase(ctrl)
-	fu.EXPECT().Read(gomock.Any(), gomock.Any()).Return(dto, nil)
+	fu.EXPECT().Read(gomock.Any(), gomock.Any(), gomock.Any()).Return(dto, nil)
 
 	fh := NewFolderHandler(fu)
 
@@ -261,3 +265,239 @@ func TestReadFolder(t *testing.T) {
 		t.Error(w.Body.String())
 	}
 }
+
+func TestArchive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/archive", strings.NewReader(`{"folder_ids":[2],"file_ids":[1],"format":"tar.gz"}`))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewFolderBodyDTO("archive.tar.gz", "application/gzip", io.NopCloser(strings.NewReader("archive")))
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+	fu.EXPECT().Archive(gomock.Any(), []uint64{2}, []uint64{1}, entity.ArchiveTarGzip, gomock.Any()).Return(dto, nil)
+
+	fh := NewFolderHandler(fu)
+
+	fh.Archive(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Content-Disposition") != "attachment; filename=archive.tar.gz" {
+		t.Errorf("unexpected content disposition: %s", w.Header().Get("Content-Disposition"))
+	}
+}
+
+func TestArchiveWithInvalidFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/archive", strings.NewReader(`{"file_ids":[1],"format":"rar"}`))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+
+	fh := NewFolderHandler(fu)
+
+	fh.Archive(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestCopyFolderWithSkip(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/folders/2/copy?conflict=skip", strings.NewReader(`{"parent_folder_id":1}`))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(2)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+	fu.EXPECT().Copy(uint64(2), uint64(1), entity.ConflictSkip, gomock.Any()).Return(nil, nil)
+
+	fh := NewFolderHandler(fu)
+
+	fh.Copy(ctx)
+
+	if ctx.Writer.Status() != http.StatusNoContent {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestListFolder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/folders/1/children?sort=updated_at&order=desc&mime_type=image/&updated_after=2024-01-01T00:00:00Z", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	files := []dto.FileInfoDTO{*dto.NewFileInfoDTO(2, 1, nil, "name", "/name", "image/png", 4, false, time.Now(), time.Now())}
+	list := dto.NewFolderListDTO([]dto.FolderInfoDTO{}, files, "cursor")
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+	fu.EXPECT().List(uint64(1), gomock.Any(), gomock.Any()).DoAndReturn(func(id uint64, query *entity.ListQuery, principal entity.Principal) (*dto.FolderListDTO, error) {
+		if query.Sort != entity.ListSortUpdatedAt || query.Order != entity.ListOrderDesc || query.Limit != 100 || query.Filter.MimeType != "image/" || !query.Filter.UpdatedAfter.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Error("failed to parse list query")
+		}
+		return list, nil
+	})
+
+	fh := NewFolderHandler(fu)
+
+	fh.List(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.FolderListResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if len(res.Files) != 1 || res.Files[0].Size != 4 || res.NextCursor == nil || *res.NextCursor != "cursor" {
+		t.Error("failed to return folder list")
+	}
+}
+
+func TestListFolderWithInvalidSort(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/folders/1/children?sort=owner", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+
+	fh := NewFolderHandler(fu)
+
+	fh.List(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestCountFolder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/folders/1/children/count", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+	fu.EXPECT().Count(uint64(1), gomock.Any(), gomock.Any()).Return(dto.NewFolderCountDTO(2, 3), nil)
+
+	fh := NewFolderHandler(fu)
+
+	fh.Count(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.FolderCountResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if res.Folders != 2 || res.Files != 3 {
+		t.Error("failed to return folder count")
+	}
+}
+
+func TestSearchFolder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/search?q=*.txt&type=file&min_size=10&folder_id=2", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	files := []dto.FileInfoDTO{*dto.NewFileInfoDTO(3, 2, nil, "name.txt", "/name/name.txt", "text/plain", 10, false, time.Now(), time.Now())}
+	list := dto.NewFolderListDTO([]dto.FolderInfoDTO{}, files, "")
+
+	fu := mock_usecase.NewMockFolderUsecase(ctrl)
+	fu.EXPECT().Search(uint64(2), gomock.Any(), gomock.Any()).DoAndReturn(func(id uint64, query *entity.ListQuery, principal entity.Principal) (*dto.FolderListDTO, error) {
+		if query.Filter.Name != "*.txt" || query.Filter.Kind != entity.ListKindFile || query.Filter.MinSize == nil || *query.Filter.MinSize != 10 || query.Filter.MaxSize != nil {
+			t.Error("failed to parse search query")
+		}
+		return list, nil
+	})
+
+	fh := NewFolderHandler(fu)
+
+	fh.Search(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+
+	var res responses.FolderListResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Error(err.Error())
+	}
+	if len(res.Files) != 1 || res.NextCursor != nil {
+		t.Error("failed to return search result")
+	}
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ShareLinkHandler interface {
+	Create(*gin.Context)
+	FindAll(*gin.Context)
+	Remove(*gin.Context)
+	Read(*gin.Context)
+}
+
+type shareLinkHandler struct {
+	usecase       usecase.ShareLinkUsecase
+	fileUsecase   usecase.FileUsecase
+	folderUsecase usecase.FolderUsecase
+}
+
+func NewShareLinkHandler(usecase usecase.ShareLinkUsecase, fileUsecase usecase.FileUsecase, folderUsecase usecase.FolderUsecase) ShareLinkHandler {
+	return &shareLinkHandler{
+		usecase:       usecase,
+		fileUsecase:   fileUsecase,
+		folderUsecase: folderUsecase,
+	}
+}
+
+func (sh *shareLinkHandler) Create(c *gin.Context) {
+	var request requests.CreateShareLinkRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := sh.usecase.Create(request.FileID, request.FolderID, request.Password, request.ExpiresAt, request.MaxDownloads, sh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sh.convertToShareLinkResponse(dto))
+}
+
+func (sh *shareLinkHandler) FindAll(c *gin.Context) {
+	dtos, err := sh.usecase.FindAll(sh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.ShareLinkResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *sh.convertToShareLinkResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (sh *shareLinkHandler) Remove(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	if err := sh.usecase.Remove(id, sh.getPrincipal(c)); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (sh *shareLinkHandler) Read(c *gin.Context) {
+	_, password, _ := c.Request.BasicAuth()
+
+	shareLink, err := sh.usecase.Open(c.Param("token"), password)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	principal := entity.Principal{
+		UserID:      shareLink.UserID,
+		ShareLinkID: shareLink.ID,
+		Scope:       entity.PermissionRead,
+		FolderID:    shareLink.FolderID,
+	}
+
+	if shareLink.FileID != nil {
+		dto, err := sh.fileUsecase.Read(*shareLink.FileID, principal)
+		if err != nil {
+			HandleError(c, err)
+			return
+		}
+
+		if dto.URL != "" {
+			if err := sh.usecase.Download(c.Param("token")); err != nil {
+				HandleError(c, err)
+				return
+			}
+			c.Redirect(http.StatusFound, dto.URL)
+			return
+		}
+
+		defer dto.Body.Close()
+
+		if isDownloadStart(c.Request) {
+			if err := sh.usecase.Download(c.Param("token")); err != nil {
+				HandleError(c, err)
+				return
+			}
+		}
+
+		c.Header("Content-Type", dto.MimeType)
+		http.ServeContent(c.Writer, c.Request, dto.Name, dto.UpdatedAt, dto.Body)
+		return
+	}
+
+	dto, err := sh.folderUsecase.Read(c.Request.Context(), *shareLink.FolderID, principal)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	defer dto.Body.Close()
+
+	if err := sh.usecase.Download(c.Param("token")); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.DataFromReader(http.StatusOK, -1, dto.MimeType, dto.Body, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": dto.Name}),
+	})
+}
+
+func isDownloadStart(req *http.Request) bool {
+	header := req.Header.Get("Range")
+	return header == "" || strings.HasPrefix(strings.TrimSpace(header), "bytes=0-")
+}
+
+func (sh *shareLinkHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (sh *shareLinkHandler) convertToShareLinkResponse(shareLink *dto.ShareLinkDTO) *responses.ShareLinkResponse {
+	return responses.NewShareLinkResponse(shareLink.ID, shareLink.Token, shareLink.FileID, shareLink.FolderID, shareLink.HasPassword, shareLink.ExpiresAt, shareLink.MaxDownloads, shareLink.DownloadCount, shareLink.CreatedAt, shareLink.UpdatedAt)
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateShareLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	fileID := uint64(1)
+	input := requests.CreateShareLinkRequest{
+		FileID:   &fileID,
+		Password: "secret",
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/shares", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewShareLinkDTO(1, 1, "token", &fileID, nil, true, nil, nil, 0, time.Now(), time.Now())
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Create(&fileID, nil, "secret", nil, nil, entity.Principal{UserID: 1}).Return(dto, nil)
+
+	sh := NewShareLinkHandler(su, mock_usecase.NewMockFileUsecase(ctrl), mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Create(ctx)
+
+	if w.Code != http.StatusCreated {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestReadShareLinkFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/s/token", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.SetBasicAuth("", "secret")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "token", Value: "token"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileID := uint64(2)
+	shareLink := dto.NewShareLinkDTO(1, 3, "token", &fileID, nil, true, nil, nil, 1, time.Now(), time.Now())
+	body := dto.NewFileBodyDTO("name", "mime/type", &readSeekCloser{strings.NewReader("file")}, "", time.Now())
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Open("token", "secret").Return(shareLink, nil)
+	su.EXPECT().Download("token").Return(nil)
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Read(fileID, entity.Principal{UserID: 3, ShareLinkID: 1, Scope: entity.PermissionRead}).Return(body, nil)
+
+	sh := NewShareLinkHandler(su, fu, mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Read(ctx)
+
+	if w.Code != http.StatusOK || w.Body.String() != "file" {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestReadShareLinkFileRange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/s/token", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Set("Range", "bytes=2-")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "token", Value: "token"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileID := uint64(2)
+	shareLink := dto.NewShareLinkDTO(1, 3, "token", &fileID, nil, false, nil, nil, 1, time.Now(), time.Now())
+	body := dto.NewFileBodyDTO("name", "mime/type", &readSeekCloser{strings.NewReader("file")}, "", time.Now())
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Open("token", "").Return(shareLink, nil)
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Read(fileID, entity.Principal{UserID: 3, ShareLinkID: 1, Scope: entity.PermissionRead}).Return(body, nil)
+
+	sh := NewShareLinkHandler(su, fu, mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Read(ctx)
+
+	if w.Code != http.StatusPartialContent || w.Body.String() != "le" {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestReadShareLinkFileNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/s/token", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "token", Value: "token"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileID := uint64(2)
+	shareLink := dto.NewShareLinkDTO(1, 3, "token", &fileID, nil, false, nil, nil, 0, time.Now(), time.Now())
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Open("token", "").Return(shareLink, nil)
+
+	fu := mock_usecase.NewMockFileUsecase(ctrl)
+	fu.EXPECT().Read(fileID, entity.Principal{UserID: 3, ShareLinkID: 1, Scope: entity.PermissionRead}).Return(nil, gorm.ErrRecordNotFound)
+
+	sh := NewShareLinkHandler(su, fu, mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Read(ctx)
+
+	if w.Code != http.StatusNotFound {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestReadShareLinkWithoutPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/s/token", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "token", Value: "token"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Open("token", "").Return(nil, entity.ErrSharePasswordRequired)
+
+	sh := NewShareLinkHandler(su, mock_usecase.NewMockFileUsecase(ctrl), mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Read(ctx)
+
+	if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestReadShareLinkExpired(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/s/token", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "token", Value: "token"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	su := mock_usecase.NewMockShareLinkUsecase(ctrl)
+	su.EXPECT().Open("token", "").Return(nil, entity.ErrShareLinkExpired)
+
+	sh := NewShareLinkHandler(su, mock_usecase.NewMockFileUsecase(ctrl), mock_usecase.NewMockFolderUsecase(ctrl))
+
+	sh.Read(ctx)
+
+	if w.Code != http.StatusGone {
+		t.Error(w.Body.String())
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler interface {
+	FindAll(*gin.Context)
+	Restore(*gin.Context)
+	Remove(*gin.Context)
+}
+
+type trashHandler struct {
+	usecase usecase.TrashUsecase
+}
+
+func NewTrashHandler(usecase usecase.TrashUsecase) TrashHandler {
+	return &trashHandler{
+		usecase: usecase,
+	}
+}
+
+func (th *trashHandler) FindAll(c *gin.Context) {
+	dtos, err := th.usecase.FindAll(th.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.TrashItemResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *th.convertToTrashItemResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (th *trashHandler) Restore(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	conflict := c.DefaultQuery("conflict", "fail")
+	if conflict != "fail" && conflict != "rename" {
+		HandleError(c, entity.NewValidationError("invalid_conflict_mode", "invalid conflict mode"))
+		return
+	}
+
+	dto, err := th.usecase.Restore(id, conflict, th.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, th.convertToTrashItemResponse(dto))
+}
+
+func (th *trashHandler) Remove(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	if err := th.usecase.Remove(id, th.getPrincipal(c)); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (th *trashHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (th *trashHandler) convertToTrashItemResponse(trashItem *dto.TrashItemDTO) *responses.TrashItemResponse {
+	return responses.NewTrashItemResponse(trashItem.ID, trashItem.FolderID, trashItem.FileID, trashItem.ParentFolderID, trashItem.Name, trashItem.Path, trashItem.CreatedAt)
+}
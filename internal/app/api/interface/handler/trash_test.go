@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRestoreTrashItemConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/trash/1/restore", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tu := mock_usecase.NewMockTrashUsecase(ctrl)
+	tu.EXPECT().Restore(uint64(1), "fail", entity.Principal{UserID: 1}).Return(nil, entity.ErrRestoreConflict)
+
+	th := NewTrashHandler(tu)
+
+	th.Restore(ctx)
+
+	if w.Code != http.StatusConflict {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRestoreTrashItemWithInvalidConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/trash/1/restore?conflict=overwrite", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+	ctx.Set("principal", entity.Principal{UserID: 1})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tu := mock_usecase.NewMockTrashUsecase(ctrl)
+
+	th := NewTrashHandler(tu)
+
+	th.Restore(ctx)
+
+	if w.Code != http.StatusBadRequest {
+		t.Error(w.Body.String())
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UploadSessionHandler interface {
+	Create(*gin.Context)
+	FindOne(*gin.Context)
+	Append(*gin.Context)
+	Complete(*gin.Context)
+	Remove(*gin.Context)
+}
+
+type uploadSessionHandler struct {
+	usecase usecase.UploadSessionUsecase
+}
+
+func NewUploadSessionHandler(usecase usecase.UploadSessionUsecase) UploadSessionHandler {
+	return &uploadSessionHandler{
+		usecase: usecase,
+	}
+}
+
+func (uh *uploadSessionHandler) Create(c *gin.Context) {
+	var request requests.CreateUploadSessionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := uh.usecase.Create(request.FolderID, request.Name, request.Size, request.IsHide, uh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	uh.setUploadHeader(c, dto)
+	c.Header("Location", "/uploads/"+dto.ID)
+	c.JSON(http.StatusCreated, uh.convertToUploadSessionResponse(dto))
+}
+
+func (uh *uploadSessionHandler) FindOne(c *gin.Context) {
+	dto, err := uh.usecase.FindOne(c.Param("id"), uh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	uh.setUploadHeader(c, dto)
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, uh.convertToUploadSessionResponse(dto))
+}
+
+func (uh *uploadSessionHandler) Append(c *gin.Context) {
+	if c.ContentType() != "application/offset+octet-stream" {
+		HandleError(c, entity.ErrInvalidContentType)
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := uh.usecase.Append(c.Param("id"), offset, c.Request.Body, uh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	uh.setUploadHeader(c, dto)
+	c.Status(http.StatusNoContent)
+}
+
+func (uh *uploadSessionHandler) Complete(c *gin.Context) {
+	dto, err := uh.usecase.Complete(c.Param("id"), uh.getPrincipal(c))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses.NewFileResponse(dto.ID, dto.FolderID, dto.OwnerID, dto.Name, dto.Path, dto.MimeType, dto.Size, dto.IsHide, dto.CreatedAt, dto.UpdatedAt))
+}
+
+func (uh *uploadSessionHandler) Remove(c *gin.Context) {
+	if err := uh.usecase.Remove(c.Param("id"), uh.getPrincipal(c)); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (uh *uploadSessionHandler) getPrincipal(c *gin.Context) entity.Principal {
+	if v, ok := c.Get("principal"); ok {
+		if principal, ok := v.(entity.Principal); ok {
+			return principal
+		}
+	}
+	return entity.Principal{}
+}
+
+func (uh *uploadSessionHandler) setUploadHeader(c *gin.Context, uploadSession *dto.UploadSessionDTO) {
+	c.Header("Upload-Offset", strconv.FormatInt(uploadSession.Offset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(uploadSession.Size, 10))
+}
+
+func (uh *uploadSessionHandler) convertToUploadSessionResponse(uploadSession *dto.UploadSessionDTO) *responses.UploadSessionResponse {
+	return responses.NewUploadSessionResponse(uploadSession.ID, uploadSession.FolderID, uploadSession.Name, uploadSession.Size, uploadSession.Offset, uploadSession.IsHide, uploadSession.CreatedAt, uploadSession.UpdatedAt)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateUploadSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.CreateUploadSessionRequest{
+		FolderID: 1,
+		Name:     "name",
+		Size:     4,
+		IsHide:   false,
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/uploads", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewUploadSessionDTO("id", 1, "name", 4, 0, false, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), entity.Principal{}).Return(dto, nil)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.Create(ctx)
+
+	if w.Code != http.StatusCreated {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Location") != "/uploads/id" {
+		t.Error("location header is not set")
+	}
+}
+
+func TestFindOneUploadSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("HEAD", "/uploads/id", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: "id"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewUploadSessionDTO("id", 1, "name", 4, 2, false, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().FindOne("id", entity.Principal{}).Return(dto, nil)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.FindOne(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Upload-Offset") != "2" || w.Header().Get("Upload-Length") != "4" {
+		t.Error("upload headers are not set")
+	}
+}
+
+func TestAppendUploadSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("PATCH", "/uploads/id", strings.NewReader("le"))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", "2")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: "id"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewUploadSessionDTO("id", 1, "name", 4, 4, false, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().Append("id", int64(2), gomock.Any(), entity.Principal{}).Return(dto, nil)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.Append(ctx)
+
+	if ctx.Writer.Status() != http.StatusNoContent {
+		t.Error(w.Body.String())
+	}
+	if w.Header().Get("Upload-Offset") != "4" {
+		t.Error("upload offset header is not set")
+	}
+}
+
+func TestAppendUploadSessionWithOffsetMismatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("PATCH", "/uploads/id", strings.NewReader("le"))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", "2")
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: "id"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().Append("id", int64(2), gomock.Any(), entity.Principal{}).Return(nil, entity.ErrUploadOffsetMismatch)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.Append(ctx)
+
+	if w.Code != http.StatusConflict {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestCompleteUploadSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("POST", "/uploads/id/complete", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: "id"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewFileInfoDTO(1, 1, nil, "name", "/name", "text/plain", 4, false, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().Complete("id", entity.Principal{}).Return(dto, nil)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.Complete(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRemoveUploadSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("DELETE", "/uploads/id", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: "id"})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUploadSessionUsecase(ctrl)
+	uu.EXPECT().Remove("id", entity.Principal{}).Return(nil)
+
+	uh := NewUploadSessionHandler(uu)
+
+	uh.Remove(ctx)
+
+	if ctx.Writer.Status() != http.StatusNoContent {
+		t.Error(w.Body.String())
+	}
+}
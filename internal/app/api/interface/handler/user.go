@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/interface/responses"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler interface {
+	Create(*gin.Context)
+	FindAll(*gin.Context)
+	Disable(*gin.Context)
+	Enable(*gin.Context)
+	Remove(*gin.Context)
+}
+
+type userHandler struct {
+	usecase usecase.UserUsecase
+}
+
+func NewUserHandler(usecase usecase.UserUsecase) UserHandler {
+	return &userHandler{
+		usecase: usecase,
+	}
+}
+
+func (uh *userHandler) Create(c *gin.Context) {
+	var request requests.CreateUserRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := uh.usecase.Create(request.Name, request.Password, request.IsAdmin)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, uh.convertToUserResponse(dto))
+}
+
+func (uh *userHandler) FindAll(c *gin.Context) {
+	dtos, err := uh.usecase.FindAll()
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := make([]responses.UserResponse, len(dtos))
+	for i, v := range dtos {
+		res[i] = *uh.convertToUserResponse(&v)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (uh *userHandler) Disable(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := uh.usecase.Disable(id)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, uh.convertToUserResponse(dto))
+}
+
+func (uh *userHandler) Enable(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	dto, err := uh.usecase.Enable(id)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, uh.convertToUserResponse(dto))
+}
+
+func (uh *userHandler) Remove(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		handleBadRequest(c, err)
+		return
+	}
+
+	if err := uh.usecase.Remove(id); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (uh *userHandler) convertToUserResponse(user *dto.UserDTO) *responses.UserResponse {
+	return responses.NewUserResponse(user.ID, user.Name, user.IsAdmin, user.IsDisabled, user.CreatedAt, user.UpdatedAt)
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"file-server/internal/app/api/interface/requests"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	input := requests.CreateUserRequest{
+		Name:     "name",
+		Password: "password",
+		IsAdmin:  false,
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	req, err := http.NewRequest("POST", "/users", bytes.NewBuffer(body))
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewUserDTO(1, "name", false, false, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().Create("name", "password", false).Return(dto, nil)
+
+	uh := NewUserHandler(uu)
+
+	uh.Create(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestFindAllUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("GET", "/users", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dtos := []dto.UserDTO{*dto.NewUserDTO(1, "name", false, false, time.Now(), time.Now())}
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().FindAll().Return(dtos, nil)
+
+	uh := NewUserHandler(uu)
+
+	uh.FindAll(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestDisableUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("PUT", "/users/1/disable", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dto := dto.NewUserDTO(1, "name", false, true, time.Now(), time.Now())
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().Disable(uint64(1)).Return(dto, nil)
+
+	uh := NewUserHandler(uu)
+
+	uh.Disable(ctx)
+
+	if w.Code != http.StatusOK {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, err := http.NewRequest("DELETE", "/users/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = req
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(1)})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uu := mock_usecase.NewMockUserUsecase(ctrl)
+	uu.EXPECT().Remove(uint64(1)).Return(gorm.ErrRecordNotFound)
+
+	uh := NewUserHandler(uu)
+
+	uh.Remove(ctx)
+
+	if ctx.Writer.Status() != http.StatusNotFound {
+		t.Error(w.Body.String())
+	}
+}
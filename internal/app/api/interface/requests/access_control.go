@@ -0,0 +1,5 @@
+package requests
+
+type GrantAccessControlRequest struct {
+	Permission string `json:"permission"`
+}
@@ -0,0 +1,10 @@
+package requests
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	FolderID  *uint64   `json:"folder_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -1,5 +1,14 @@
 package requests
 
 type SigninRequest struct {
+	Name     string `json:"name"`
 	Password string `json:"password"`
 }
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type SignoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
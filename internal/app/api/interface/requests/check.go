@@ -0,0 +1,5 @@
+package requests
+
+type RepairRequest struct {
+	DryRun bool `form:"dry_run"`
+}
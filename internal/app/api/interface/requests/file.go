@@ -17,3 +17,7 @@ type MoveFileRequest struct {
 type CopyFileRequest struct {
 	FolderID uint64 `json:"folder_id"`
 }
+
+type ExtractFileRequest struct {
+	FolderID uint64 `json:"folder_id"`
+}
@@ -1,5 +1,7 @@
 package requests
 
+import "time"
+
 type CreateFolderRequest struct {
 	ParentFolderID uint64 `json:"parent_folder_id"`
 	Name           string `json:"name"`
@@ -18,3 +20,34 @@ type MoveFolderRequest struct {
 type CopyFolderRequest struct {
 	ParentFolderID uint64 `json:"parent_folder_id"`
 }
+
+type FilterFolderRequest struct {
+	MimeType      string    `form:"mime_type"`
+	CreatedAfter  time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	UpdatedAfter  time.Time `form:"updated_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	UpdatedBefore time.Time `form:"updated_before" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type ListFolderRequest struct {
+	FilterFolderRequest
+	Sort   string `form:"sort,default=name"`
+	Order  string `form:"order,default=asc"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit,default=100"`
+}
+
+type SearchRequest struct {
+	ListFolderRequest
+	FolderID uint64 `form:"folder_id,default=1"`
+	Query    string `form:"q"`
+	Type     string `form:"type"`
+	MinSize  *int64 `form:"min_size"`
+	MaxSize  *int64 `form:"max_size"`
+}
+
+type ArchiveRequest struct {
+	FolderIDs []uint64 `json:"folder_ids"`
+	FileIDs   []uint64 `json:"file_ids"`
+	Format    string   `json:"format"`
+}
@@ -0,0 +1,11 @@
+package requests
+
+import "time"
+
+type CreateShareLinkRequest struct {
+	FileID       *uint64    `json:"file_id"`
+	FolderID     *uint64    `json:"folder_id"`
+	Password     string     `json:"password"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	MaxDownloads *uint64    `json:"max_downloads"`
+}
@@ -0,0 +1,8 @@
+package requests
+
+type CreateUploadSessionRequest struct {
+	FolderID uint64 `json:"folder_id"`
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	IsHide   bool   `json:"is_hide"`
+}
@@ -0,0 +1,7 @@
+package requests
+
+type CreateUserRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	IsAdmin  bool   `json:"is_admin"`
+}
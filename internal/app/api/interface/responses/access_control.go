@@ -0,0 +1,23 @@
+package responses
+
+import "time"
+
+type AccessControlResponse struct {
+	ID         uint64    `json:"id"`
+	FolderID   uint64    `json:"folder_id"`
+	UserID     uint64    `json:"user_id"`
+	Permission string    `json:"permission"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func NewAccessControlResponse(id uint64, folderID uint64, userID uint64, permission string, createdAt time.Time, updatedAt time.Time) *AccessControlResponse {
+	return &AccessControlResponse{
+		ID:         id,
+		FolderID:   folderID,
+		UserID:     userID,
+		Permission: permission,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
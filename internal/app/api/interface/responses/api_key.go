@@ -0,0 +1,29 @@
+package responses
+
+import "time"
+
+type APIKeyResponse struct {
+	ID         uint64     `json:"id"`
+	Name       string     `json:"name"`
+	Key        string     `json:"key,omitempty"`
+	Scope      string     `json:"scope"`
+	FolderID   *uint64    `json:"folder_id"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func NewAPIKeyResponse(id uint64, name string, key string, scope string, folderID *uint64, expiresAt time.Time, lastUsedAt *time.Time, createdAt time.Time, updatedAt time.Time) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         id,
+		Name:       name,
+		Key:        key,
+		Scope:      scope,
+		FolderID:   folderID,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
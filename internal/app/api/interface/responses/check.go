@@ -0,0 +1,23 @@
+package responses
+
+type InconsistencyResponse struct {
+	Kind         string `json:"kind"`
+	ID           uint64 `json:"id,omitempty"`
+	Path         string `json:"path"`
+	ExpectedPath string `json:"expected_path,omitempty"`
+	Reason       string `json:"reason"`
+	Action       string `json:"action"`
+	Repaired     bool   `json:"repaired"`
+}
+
+func NewInconsistencyResponse(kind string, id uint64, path string, expectedPath string, reason string, action string, repaired bool) *InconsistencyResponse {
+	return &InconsistencyResponse{
+		Kind:         kind,
+		ID:           id,
+		Path:         path,
+		ExpectedPath: expectedPath,
+		Reason:       reason,
+		Action:       action,
+		Repaired:     repaired,
+	}
+}
@@ -5,21 +5,25 @@ import "time"
 type FileResponse struct {
 	ID        uint64    `json:"id"`
 	FolderID  uint64    `json:"folder_id"`
+	OwnerID   *uint64   `json:"owner_id"`
 	Name      string    `json:"name"`
 	Path      string    `json:"path"`
 	MimeType  string    `json:"mime_type"`
+	Size      int64     `json:"size"`
 	IsHide    bool      `json:"is_hide"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-func NewFileResponse(id uint64, folderID uint64, name string, path string, mimeType string, isHide bool, createdAt time.Time, updatedAt time.Time) *FileResponse {
+func NewFileResponse(id uint64, folderID uint64, ownerID *uint64, name string, path string, mimeType string, size int64, isHide bool, createdAt time.Time, updatedAt time.Time) *FileResponse {
 	return &FileResponse{
 		ID:        id,
 		FolderID:  folderID,
+		OwnerID:   ownerID,
 		Name:      name,
 		Path:      path,
 		MimeType:  mimeType,
+		Size:      size,
 		IsHide:    isHide,
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
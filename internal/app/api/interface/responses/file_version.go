@@ -0,0 +1,19 @@
+package responses
+
+import "time"
+
+type FileVersionResponse struct {
+	ID        uint64    `json:"id"`
+	FileID    uint64    `json:"file_id"`
+	MimeType  string    `json:"mime_type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewFileVersionResponse(id uint64, fileID uint64, mimeType string, createdAt time.Time) *FileVersionResponse {
+	return &FileVersionResponse{
+		ID:        id,
+		FileID:    fileID,
+		MimeType:  mimeType,
+		CreatedAt: createdAt,
+	}
+}
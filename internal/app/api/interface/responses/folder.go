@@ -5,6 +5,7 @@ import "time"
 type FolderResponse struct {
 	ID             uint64           `json:"id"`
 	ParentFolderID *uint64          `json:"parent_folder_id"`
+	OwnerID        *uint64          `json:"owner_id"`
 	Name           string           `json:"name"`
 	Path           string           `json:"path"`
 	IsHide         bool             `json:"is_hide"`
@@ -14,10 +15,11 @@ type FolderResponse struct {
 	UpdatedAt      time.Time        `json:"updated_at"`
 }
 
-func NewFolderResponse(id uint64, parentFolderID *uint64, name string, path string, isHide bool, folders []FolderResponse, files []FileResponse, createdAt time.Time, updatedAt time.Time) *FolderResponse {
+func NewFolderResponse(id uint64, parentFolderID *uint64, ownerID *uint64, name string, path string, isHide bool, folders []FolderResponse, files []FileResponse, createdAt time.Time, updatedAt time.Time) *FolderResponse {
 	return &FolderResponse{
 		ID:             id,
 		ParentFolderID: parentFolderID,
+		OwnerID:        ownerID,
 		Name:           name,
 		Path:           path,
 		IsHide:         isHide,
@@ -27,3 +29,29 @@ func NewFolderResponse(id uint64, parentFolderID *uint64, name string, path stri
 		UpdatedAt:      updatedAt,
 	}
 }
+
+type FolderListResponse struct {
+	Folders    []FolderResponse `json:"folders"`
+	Files      []FileResponse   `json:"files"`
+	NextCursor *string          `json:"next_cursor"`
+}
+
+func NewFolderListResponse(folders []FolderResponse, files []FileResponse, nextCursor *string) *FolderListResponse {
+	return &FolderListResponse{
+		Folders:    folders,
+		Files:      files,
+		NextCursor: nextCursor,
+	}
+}
+
+type FolderCountResponse struct {
+	Folders int64 `json:"folders"`
+	Files   int64 `json:"files"`
+}
+
+func NewFolderCountResponse(folders int64, files int64) *FolderCountResponse {
+	return &FolderCountResponse{
+		Folders: folders,
+		Files:   files,
+	}
+}
@@ -0,0 +1,24 @@
+package responses
+
+type ProblemResponse struct {
+	Type     string  `json:"type"`
+	Title    string  `json:"title"`
+	Status   int     `json:"status"`
+	Detail   string  `json:"detail"`
+	Instance string  `json:"instance"`
+	Code     string  `json:"code"`
+	Path     string  `json:"path,omitempty"`
+	FolderID *uint64 `json:"folder_id,omitempty"`
+	FileID   *uint64 `json:"file_id,omitempty"`
+}
+
+func NewProblemResponse(status int, title string, detail string, instance string, code string) *ProblemResponse {
+	return &ProblemResponse{
+		Type:     "about:blank",
+		Title:    title,
+		Status:   status,
+		Detail:   detail,
+		Instance: instance,
+		Code:     code,
+	}
+}
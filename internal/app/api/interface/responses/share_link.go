@@ -0,0 +1,31 @@
+package responses
+
+import "time"
+
+type ShareLinkResponse struct {
+	ID            uint64     `json:"id"`
+	Token         string     `json:"token"`
+	FileID        *uint64    `json:"file_id"`
+	FolderID      *uint64    `json:"folder_id"`
+	HasPassword   bool       `json:"has_password"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	MaxDownloads  *uint64    `json:"max_downloads"`
+	DownloadCount uint64     `json:"download_count"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func NewShareLinkResponse(id uint64, token string, fileID *uint64, folderID *uint64, hasPassword bool, expiresAt *time.Time, maxDownloads *uint64, downloadCount uint64, createdAt time.Time, updatedAt time.Time) *ShareLinkResponse {
+	return &ShareLinkResponse{
+		ID:            id,
+		Token:         token,
+		FileID:        fileID,
+		FolderID:      folderID,
+		HasPassword:   hasPassword,
+		ExpiresAt:     expiresAt,
+		MaxDownloads:  maxDownloads,
+		DownloadCount: downloadCount,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package responses
+
+import "time"
+
+type TrashItemResponse struct {
+	ID             uint64    `json:"id"`
+	FolderID       *uint64   `json:"folder_id"`
+	FileID         *uint64   `json:"file_id"`
+	ParentFolderID *uint64   `json:"parent_folder_id"`
+	Name           string    `json:"name"`
+	Path           string    `json:"path"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func NewTrashItemResponse(id uint64, folderID *uint64, fileID *uint64, parentFolderID *uint64, name string, path string, createdAt time.Time) *TrashItemResponse {
+	return &TrashItemResponse{
+		ID:             id,
+		FolderID:       folderID,
+		FileID:         fileID,
+		ParentFolderID: parentFolderID,
+		Name:           name,
+		Path:           path,
+		CreatedAt:      createdAt,
+	}
+}
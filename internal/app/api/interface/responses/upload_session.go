@@ -0,0 +1,27 @@
+package responses
+
+import "time"
+
+type UploadSessionResponse struct {
+	ID        string    `json:"id"`
+	FolderID  uint64    `json:"folder_id"`
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	Offset    int64     `json:"offset"`
+	IsHide    bool      `json:"is_hide"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewUploadSessionResponse(id string, folderID uint64, name string, size int64, offset int64, isHide bool, createdAt time.Time, updatedAt time.Time) *UploadSessionResponse {
+	return &UploadSessionResponse{
+		ID:        id,
+		FolderID:  folderID,
+		Name:      name,
+		Size:      size,
+		Offset:    offset,
+		IsHide:    isHide,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
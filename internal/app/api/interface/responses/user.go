@@ -0,0 +1,23 @@
+package responses
+
+import "time"
+
+type UserResponse struct {
+	ID         uint64    `json:"id"`
+	Name       string    `json:"name"`
+	IsAdmin    bool      `json:"is_admin"`
+	IsDisabled bool      `json:"is_disabled"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func NewUserResponse(id uint64, name string, isAdmin bool, isDisabled bool, createdAt time.Time, updatedAt time.Time) *UserResponse {
+	return &UserResponse{
+		ID:         id,
+		Name:       name,
+		IsAdmin:    isAdmin,
+		IsDisabled: isDisabled,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
@@ -3,8 +3,10 @@ package api
 import (
 	"bytes"
 	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/interface/handler"
 	"file-server/internal/app/api/interface/requests"
-	"file-server/internal/pkg/config"
+	"file-server/internal/app/api/usecase/dto"
 	"net/http"
 	"strings"
 	"sync"
@@ -13,30 +15,94 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+var errUnauthorized = entity.NewError(entity.ErrUnauthorized, "unauthorized", "unauthorized")
+
 func authMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if c.Request.Header.Get("Authorization") != "" {
 			token := strings.Split(c.Request.Header.Get("Authorization"), " ")
 			if len(token) != 2 || token[0] != "Bearer" {
-				c.String(http.StatusUnauthorized, "invalid token")
+				handler.HandleError(c, entity.ErrInvalidToken)
 				c.Abort()
 				return
 			}
 
-			claims := jwt.MapClaims{}
-			_, err := jwt.ParseWithClaims(token[1], claims, func(token *jwt.Token) (interface{}, error) {
-				return []byte(config.JWT_SECRET_KEY), nil
-			})
-			c.Set("isDisplayHiddenObject", true)
+			var user *dto.UserDTO
+			var principal entity.Principal
+			var err error
+			if entity.IsAPIKey(token[1]) {
+				user, principal, err = authenticateAPIKey(token[1])
+			} else if user, err = authUsecase.Authenticate(token[1]); err == nil {
+				principal = entity.Principal{UserID: user.ID}
+				c.Set("token", token[1])
+			}
 			if err != nil {
 				if errors.Is(err, jwt.ErrTokenExpired) {
-					c.Set("isDisplayHiddenObject", false)
+					handler.HandleError(c, entity.NewError(entity.ErrUnauthorized, "token_expired", "token expired"))
+				} else if errors.Is(err, entity.ErrInvalidCredential) || errors.Is(err, entity.ErrUserDisabled) {
+					handler.HandleError(c, entity.ErrInvalidToken)
 				} else {
-					c.String(http.StatusUnauthorized, "invalid token")
-					c.Abort()
-					return
+					handler.HandleError(c, err)
 				}
+				c.Abort()
+				return
 			}
+			c.Set("user", user)
+			c.Set("principal", principal)
+		}
+
+		if _, ok := c.Get("user"); !ok && !isAnonymousAllowed(c.Request.Method) {
+			handler.HandleError(c, errUnauthorized)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func authenticateAPIKey(key string) (*dto.UserDTO, entity.Principal, error) {
+	user, apiKey, err := apiKeyUsecase.Authenticate(key)
+	if err != nil {
+		return nil, entity.Principal{}, err
+	}
+	scope, err := entity.NewPermission(apiKey.Scope)
+	if err != nil {
+		return nil, entity.Principal{}, err
+	}
+	return user, entity.Principal{
+		UserID:   user.ID,
+		APIKeyID: apiKey.ID,
+		Scope:    scope,
+		FolderID: apiKey.FolderID,
+	}, nil
+}
+
+func isAnonymousAllowed(method string) bool {
+	if !authPolicy.AllowsAnonymous() {
+		return false
+	}
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func adminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, ok := c.Get("user")
+		if !ok {
+			handler.HandleError(c, errUnauthorized)
+			c.Abort()
+			return
+		}
+
+		if user, ok := v.(*dto.UserDTO); !ok || !user.IsAdmin {
+			handler.HandleError(c, entity.ErrPermissionDenied)
+			c.Abort()
+			return
+		}
+		if principal, ok := c.Get("principal"); ok && principal.(entity.Principal).IsAPIKey() {
+			handler.HandleError(c, entity.ErrPermissionDenied)
+			c.Abort()
+			return
 		}
 
 		c.Next()
@@ -66,7 +132,7 @@ func batchMiddleware(engine *gin.Engine) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var requests []requests.BatchRequest
 		if err := c.ShouldBindJSON(&requests); err != nil {
-			c.JSON(http.StatusBadRequest, err.Error())
+			handler.HandleError(c, entity.NewValidationError("invalid_request", err.Error()))
 			c.Abort()
 			return
 		}
@@ -92,7 +158,7 @@ func batchMiddleware(engine *gin.Engine) gin.HandlerFunc {
 
 				r, err := http.NewRequest(req.Method, req.Path, bytes.NewBuffer([]byte(req.Body)))
 				if err != nil {
-					c.JSON(http.StatusInternalServerError, err.Error())
+					handler.HandleError(c, err)
 					c.Abort()
 					return
 				}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/usecase"
+	"file-server/internal/app/api/usecase/dto"
+	mock_usecase "file-server/test/mock/usecase"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAuthMiddlewareWithAnonymous(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func(policy entity.AuthPolicy) { authPolicy = policy }(authPolicy)
+
+	for _, v := range []struct {
+		policy entity.AuthPolicy
+		method string
+		status int
+	}{
+		{entity.AuthPolicyPublic, http.MethodGet, http.StatusOK},
+		{entity.AuthPolicyPublic, http.MethodHead, http.StatusOK},
+		{entity.AuthPolicyPublic, http.MethodPost, http.StatusUnauthorized},
+		{entity.AuthPolicyPublic, http.MethodPut, http.StatusUnauthorized},
+		{entity.AuthPolicyPublic, http.MethodDelete, http.StatusUnauthorized},
+		{entity.AuthPolicyAuthenticated, http.MethodGet, http.StatusOK},
+		{entity.AuthPolicyAuthenticated, http.MethodHead, http.StatusOK},
+		{entity.AuthPolicyAuthenticated, http.MethodPost, http.StatusUnauthorized},
+		{entity.AuthPolicyAuthenticated, http.MethodPut, http.StatusUnauthorized},
+		{entity.AuthPolicyAuthenticated, http.MethodDelete, http.StatusUnauthorized},
+		{entity.AuthPolicyPrivate, http.MethodGet, http.StatusUnauthorized},
+		{entity.AuthPolicyPrivate, http.MethodHead, http.StatusUnauthorized},
+		{entity.AuthPolicyPrivate, http.MethodPost, http.StatusUnauthorized},
+		{entity.AuthPolicyPrivate, http.MethodPut, http.StatusUnauthorized},
+		{entity.AuthPolicyPrivate, http.MethodDelete, http.StatusUnauthorized},
+	} {
+		authPolicy = v.policy
+
+		r := gin.New()
+		r.Handle(v.method, "/files/:id", authMiddleware(), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		req, err := http.NewRequest(v.method, "/files/1", nil)
+		if err != nil {
+			t.Error(err.Error())
+		}
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != v.status {
+			t.Errorf("%s %s under %s policy: expected %d, got %d", v.method, req.URL.Path, v.policy, v.status, w.Code)
+		}
+	}
+}
+
+func TestAuthMiddlewareWithInvalidToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func(policy entity.AuthPolicy) { authPolicy = policy }(authPolicy)
+	authPolicy = entity.AuthPolicyPublic
+
+	r := gin.New()
+	r.GET("/files/:id", authMiddleware(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "/files/1", nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	req.Header.Set("Authorization", "Basic token")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Error(w.Body.String())
+	}
+}
+
+func TestAuthMiddlewareWithUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func(policy entity.AuthPolicy) { authPolicy = policy }(authPolicy)
+	defer func(uc usecase.AuthUsecase) { authUsecase = uc }(authUsecase)
+	authPolicy = entity.AuthPolicyPrivate
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	au := mock_usecase.NewMockAuthUsecase(ctrl)
+	au.EXPECT().Authenticate("token").Return(&dto.UserDTO{ID: 1}, nil).Times(2)
+	authUsecase = au
+
+	r := gin.New()
+	handler := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+	r.GET("/files/:id", authMiddleware(), handler)
+	r.DELETE("/files/:id", authMiddleware(), handler)
+
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		req, err := http.NewRequest(method, "/files/1", nil)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		req.Header.Set("Authorization", "Bearer token")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("%s %s: %s", method, req.URL.Path, w.Body.String())
+		}
+	}
+}
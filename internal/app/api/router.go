@@ -6,33 +6,100 @@ func route(r *gin.Engine) {
 	auth := r.Group("/auth")
 	{
 		auth.POST("/signin", authHandler.Signin)
+		auth.POST("/refresh", authHandler.Refresh)
+		auth.POST("/signout", authMiddleware(), authHandler.Signout)
 	}
 
-	folders := r.Group("/folders")
+	users := r.Group("/users", authMiddleware(), adminMiddleware())
 	{
-		folders.POST("/", folderHandler.Create)
+		users.GET("/", userHandler.FindAll)
+		users.POST("/", userHandler.Create)
+		users.DELETE("/:id", userHandler.Remove)
+		users.PUT("/:id/disable", userHandler.Disable)
+		users.PUT("/:id/enable", userHandler.Enable)
+	}
+
+	admin := r.Group("/admin", authMiddleware(), adminMiddleware())
+	{
+		admin.GET("/check", checkHandler.Check)
+		admin.POST("/check/repair", checkHandler.Repair)
+	}
 
-		folders.Use(authMiddleware())
+	apiKeys := r.Group("/api-keys", authMiddleware())
+	{
+		apiKeys.GET("/", apiKeyHandler.FindAll)
+		apiKeys.POST("/", apiKeyHandler.Create)
+		apiKeys.DELETE("/:id", apiKeyHandler.Remove)
+	}
 
+	shares := r.Group("/shares", authMiddleware())
+	{
+		shares.GET("/", shareLinkHandler.FindAll)
+		shares.POST("/", shareLinkHandler.Create)
+		shares.DELETE("/:id", shareLinkHandler.Remove)
+	}
+
+	s := r.Group("/s")
+	{
+		s.GET("/:token", shareLinkHandler.Read)
+	}
+
+	folders := r.Group("/folders", authMiddleware())
+	{
+		folders.POST("/", folderHandler.Create)
 		folders.GET("/find/*path", folderHandler.FindOne)
 		folders.PUT("/:id", folderHandler.Update)
 		folders.DELETE("/:id", folderHandler.Remove)
+		folders.GET("/:id/children", folderHandler.List)
+		folders.GET("/:id/children/count", folderHandler.Count)
 		folders.GET("/:id/body", folderHandler.Read)
 		folders.PUT("/:id/move", folderHandler.Move)
 		folders.POST("/:id/copy", folderHandler.Copy)
+		folders.GET("/:id/acl", accessControlHandler.FindAll)
+		folders.PUT("/:id/acl/:user_id", accessControlHandler.Grant)
+		folders.DELETE("/:id/acl/:user_id", accessControlHandler.Revoke)
 	}
 
-	files := r.Group("/files")
+	files := r.Group("/files", authMiddleware())
 	{
 		files.POST("/", fileHandler.Create)
-
-		files.Use(authMiddleware())
-
 		files.PUT("/:id", fileHandler.Update)
 		files.DELETE("/:id", fileHandler.Remove)
 		files.GET("/:id/body", fileHandler.Read)
+		files.PUT("/:id/body", fileHandler.Overwrite)
 		files.PUT("/:id/move", fileHandler.Move)
 		files.POST("/:id/copy", fileHandler.Copy)
+		files.POST("/:id/extract", fileHandler.Extract)
+		files.GET("/:id/versions", fileVersionHandler.FindAll)
+		files.GET("/:id/versions/:version_id/body", fileVersionHandler.Read)
+		files.POST("/:id/versions/:version_id/restore", fileVersionHandler.Restore)
+	}
+
+	search := r.Group("/search", authMiddleware())
+	{
+		search.GET("/", folderHandler.Search)
+	}
+
+	archive := r.Group("/archive", authMiddleware())
+	{
+		archive.POST("/", folderHandler.Archive)
+	}
+
+	trash := r.Group("/trash", authMiddleware())
+	{
+		trash.GET("/", trashHandler.FindAll)
+		trash.POST("/:id/restore", trashHandler.Restore)
+		trash.DELETE("/:id", trashHandler.Remove)
+	}
+
+	uploads := r.Group("/uploads", authMiddleware())
+	{
+		uploads.POST("/", uploadSessionHandler.Create)
+		uploads.HEAD("/:id", uploadSessionHandler.FindOne)
+		uploads.GET("/:id", uploadSessionHandler.FindOne)
+		uploads.PATCH("/:id", uploadSessionHandler.Append)
+		uploads.DELETE("/:id", uploadSessionHandler.Remove)
+		uploads.POST("/:id/complete", uploadSessionHandler.Complete)
 	}
 
 	batch := r.Group("/batch")
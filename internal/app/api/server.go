@@ -4,6 +4,7 @@ import (
 	"context"
 	"file-server/internal/pkg/config"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"os/signal"
@@ -24,7 +25,12 @@ func Serve() {
 	if err != nil {
 		panic(err)
 	}
-	inject(db)
+	if err := inject(db); err != nil {
+		panic(err)
+	}
+	if err := unitOfWork.Recover(db); err != nil {
+		log.Println(err)
+	}
 
 	r := gin.Default()
 	route(r)
@@ -43,6 +49,10 @@ func Serve() {
 		}
 	}()
 
+	go removeExpiredUploadSessions(ctx)
+	go removeExpiredRevokedTokens(ctx)
+	go removeExpiredTrashItems(ctx)
+
 	<-ctx.Done()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -52,3 +62,51 @@ func Serve() {
 		panic(err)
 	}
 }
+
+func removeExpiredUploadSessions(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := uploadSessionUsecase.RemoveExpired(now.Add(-config.UPLOAD_SESSION_EXPIRATION)); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+func removeExpiredRevokedTokens(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := authUsecase.RemoveExpired(now); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+func removeExpiredTrashItems(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := trashUsecase.RemoveExpired(now.Add(-config.TRASH_RETENTION)); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+
+	"gorm.io/gorm"
+)
+
+type AccessControlUsecase interface {
+	FindAll(uint64, entity.Principal) ([]dto.AccessControlDTO, error)
+	Grant(uint64, uint64, string, entity.Principal) (*dto.AccessControlDTO, error)
+	Revoke(uint64, uint64, entity.Principal) error
+}
+
+type accessControlUsecase struct {
+	db                      *gorm.DB
+	accessControlRepository repository.AccessControlRepository
+	folderInfoRepository    repository.FolderInfoRepository
+	userRepository          repository.UserRepository
+	accessControlService    service.AccessControlService
+}
+
+func NewAccessControlUsecase(db *gorm.DB, accessControlRepository repository.AccessControlRepository, folderInfoRepository repository.FolderInfoRepository, userRepository repository.UserRepository, accessControlService service.AccessControlService) AccessControlUsecase {
+	return &accessControlUsecase{
+		db:                      db,
+		accessControlRepository: accessControlRepository,
+		folderInfoRepository:    folderInfoRepository,
+		userRepository:          userRepository,
+		accessControlService:    accessControlService,
+	}
+}
+
+func (au *accessControlUsecase) FindAll(folderID uint64, principal entity.Principal) ([]dto.AccessControlDTO, error) {
+	if _, err := au.authorize(au.db, folderID, principal, entity.PermissionShare); err != nil {
+		return nil, err
+	}
+
+	entries, err := au.accessControlRepository.FindAllByFolderID(au.db, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.AccessControlDTO, len(entries))
+	for i, v := range entries {
+		dtos[i] = *au.convertToAccessControlDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (au *accessControlUsecase) Grant(folderID uint64, targetUserID uint64, permission string, principal entity.Principal) (*dto.AccessControlDTO, error) {
+	var entry *entity.AccessControlEntry
+	if err := au.db.Transaction(func(tx *gorm.DB) error {
+		p, err := entity.NewPermission(permission)
+		if err != nil {
+			return err
+		}
+
+		granted, err := au.authorize(tx, folderID, principal, entity.PermissionShare)
+		if err != nil {
+			return err
+		}
+		if err := au.authorizeGrant(granted, p); err != nil {
+			return err
+		}
+
+		if _, err := au.userRepository.FindOneByID(tx, targetUserID); err != nil {
+			return err
+		}
+
+		entry, err = au.accessControlRepository.FindOneByFolderIDAndUserID(tx, folderID, targetUserID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			entry, err = entity.NewAccessControlEntry(folderID, targetUserID, permission)
+			if err != nil {
+				return err
+			}
+			entry, err = au.accessControlRepository.Create(tx, entry)
+			return err
+		}
+
+		if err := au.authorizeGrant(granted, entry.Permission); err != nil {
+			return err
+		}
+		entry.Permission = p
+		entry, err = au.accessControlRepository.Update(tx, entry)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return au.convertToAccessControlDTO(entry), nil
+}
+
+func (au *accessControlUsecase) Revoke(folderID uint64, targetUserID uint64, principal entity.Principal) error {
+	return au.db.Transaction(func(tx *gorm.DB) error {
+		granted, err := au.authorize(tx, folderID, principal, entity.PermissionShare)
+		if err != nil {
+			return err
+		}
+
+		entry, err := au.accessControlRepository.FindOneByFolderIDAndUserID(tx, folderID, targetUserID)
+		if err != nil {
+			return err
+		}
+
+		if err := au.authorizeGrant(granted, entry.Permission); err != nil {
+			return err
+		}
+
+		return au.accessControlRepository.Remove(tx, entry)
+	})
+}
+
+func (au *accessControlUsecase) authorize(db *gorm.DB, folderID uint64, principal entity.Principal, required entity.Permission) (entity.Permission, error) {
+	folder, err := au.folderInfoRepository.FindOneByID(db, folderID)
+	if err != nil {
+		return entity.PermissionNone, err
+	}
+	permission, err := au.accessControlService.FolderPermission(db, principal, folder)
+	if err != nil {
+		return entity.PermissionNone, err
+	}
+	return permission, authorize(permission, required)
+}
+
+func (au *accessControlUsecase) authorizeGrant(granted entity.Permission, permission entity.Permission) error {
+	if permission.Allows(entity.PermissionShare) && !granted.Allows(entity.PermissionAdmin) {
+		return entity.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (au *accessControlUsecase) convertToAccessControlDTO(entry *entity.AccessControlEntry) *dto.AccessControlDTO {
+	return dto.NewAccessControlDTO(entry.ID, entry.FolderID, entry.UserID, entry.Permission.String(), entry.CreatedAt, entry.UpdatedAt)
+}
+
+func authorize(permission entity.Permission, required entity.Permission) error {
+	if !permission.Allows(entity.PermissionRead) {
+		return gorm.ErrRecordNotFound
+	}
+	if !permission.Allows(required) {
+		return entity.ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestFindAllAccessControl(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	entry, err := entity.NewAccessControlEntry(1, 2, "read")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accessControlRepository := mock_repository.NewMockAccessControlRepository(ctrl)
+	accessControlRepository.EXPECT().FindAllByFolderID(gomock.Any(), folderInfo.ID).Return([]entity.AccessControlEntry{*entry}, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionShare, nil)
+
+	au := NewAccessControlUsecase(db, accessControlRepository, folderInfoRepository, userRepository, accessControlService)
+
+	result, err := au.FindAll(folderInfo.ID, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].Permission != "read" {
+		t.Error("failed to find access control entries")
+	}
+}
+
+func TestGrantAccessControl(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	user, err := entity.NewUser("user", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 2
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accessControlRepository := mock_repository.NewMockAccessControlRepository(ctrl)
+	accessControlRepository.EXPECT().FindOneByFolderIDAndUserID(gomock.Any(), folderInfo.ID, user.ID).Return(nil, gorm.ErrRecordNotFound)
+	accessControlRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, e *entity.AccessControlEntry) (*entity.AccessControlEntry, error) {
+		e.ID = 1
+		return e, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionShare, nil)
+
+	au := NewAccessControlUsecase(db, accessControlRepository, folderInfoRepository, userRepository, accessControlService)
+
+	result, err := au.Grant(folderInfo.ID, user.ID, "write", entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.UserID != user.ID || result.Permission != "write" {
+		t.Error("failed to grant access control")
+	}
+}
+
+func TestGrantAccessControlWithoutAdmin(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accessControlRepository := mock_repository.NewMockAccessControlRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionShare, nil)
+
+	au := NewAccessControlUsecase(db, accessControlRepository, folderInfoRepository, userRepository, accessControlService)
+
+	if _, err := au.Grant(folderInfo.ID, 2, "admin", entity.Principal{UserID: 1}); !errors.Is(err, entity.ErrPermissionDenied) {
+		t.Error("admin permission is granted without admin")
+	}
+}
+
+func TestRevokeAccessControl(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	entry, err := entity.NewAccessControlEntry(1, 2, "read")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accessControlRepository := mock_repository.NewMockAccessControlRepository(ctrl)
+	accessControlRepository.EXPECT().FindOneByFolderIDAndUserID(gomock.Any(), folderInfo.ID, uint64(2)).Return(entry, nil)
+	accessControlRepository.EXPECT().Remove(gomock.Any(), entry).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionAdmin, nil)
+
+	au := NewAccessControlUsecase(db, accessControlRepository, folderInfoRepository, userRepository, accessControlService)
+
+	if err := au.Revoke(folderInfo.ID, 2, entity.Principal{UserID: 1}); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type APIKeyUsecase interface {
+	Create(string, string, *uint64, time.Time, entity.Principal) (*dto.APIKeyDTO, error)
+	FindAll(entity.Principal) ([]dto.APIKeyDTO, error)
+	Remove(uint64, entity.Principal) error
+	Authenticate(string) (*dto.UserDTO, *dto.APIKeyDTO, error)
+}
+
+type apiKeyUsecase struct {
+	db                   *gorm.DB
+	apiKeyRepository     repository.APIKeyRepository
+	folderInfoRepository repository.FolderInfoRepository
+	userRepository       repository.UserRepository
+	accessControlService service.AccessControlService
+}
+
+func NewAPIKeyUsecase(db *gorm.DB, apiKeyRepository repository.APIKeyRepository, folderInfoRepository repository.FolderInfoRepository, userRepository repository.UserRepository, accessControlService service.AccessControlService) APIKeyUsecase {
+	return &apiKeyUsecase{
+		db:                   db,
+		apiKeyRepository:     apiKeyRepository,
+		folderInfoRepository: folderInfoRepository,
+		userRepository:       userRepository,
+		accessControlService: accessControlService,
+	}
+}
+
+func (au *apiKeyUsecase) Create(name string, scope string, folderID *uint64, expiresAt time.Time, principal entity.Principal) (*dto.APIKeyDTO, error) {
+	if principal.UserID == 0 || principal.IsAPIKey() {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	var apiKey *entity.APIKey
+	var key string
+	if err := au.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		apiKey, key, err = entity.NewAPIKey(principal.UserID, name, scope, folderID, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		if folderID != nil {
+			folderInfo, err := au.folderInfoRepository.FindOneByID(tx, *folderID)
+			if err != nil {
+				return err
+			}
+			permission, err := au.accessControlService.FolderPermission(tx, principal, folderInfo)
+			if err != nil {
+				return err
+			}
+			if err := authorize(permission, apiKey.Scope); err != nil {
+				return err
+			}
+		}
+
+		apiKey, err = au.apiKeyRepository.Create(tx, apiKey)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return au.convertToAPIKeyDTO(apiKey, key), nil
+}
+
+func (au *apiKeyUsecase) FindAll(principal entity.Principal) ([]dto.APIKeyDTO, error) {
+	if principal.UserID == 0 || principal.IsAPIKey() {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	apiKeys, err := au.apiKeyRepository.FindAllByUserID(au.db, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.APIKeyDTO, len(apiKeys))
+	for i, v := range apiKeys {
+		dtos[i] = *au.convertToAPIKeyDTO(&v, "")
+	}
+	return dtos, nil
+}
+
+func (au *apiKeyUsecase) Remove(id uint64, principal entity.Principal) error {
+	if principal.UserID == 0 || principal.IsAPIKey() {
+		return entity.ErrPermissionDenied
+	}
+
+	return au.db.Transaction(func(tx *gorm.DB) error {
+		apiKey, err := au.apiKeyRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+		if apiKey.UserID != principal.UserID {
+			return gorm.ErrRecordNotFound
+		}
+
+		return au.apiKeyRepository.Remove(tx, apiKey)
+	})
+}
+
+func (au *apiKeyUsecase) Authenticate(key string) (*dto.UserDTO, *dto.APIKeyDTO, error) {
+	prefix, secret, err := entity.ParseAPIKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiKey, err := au.apiKeyRepository.FindOneByPrefix(au.db, prefix)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, entity.ErrInvalidCredential
+		}
+		return nil, nil, err
+	}
+	now := time.Now()
+	if err := apiKey.Authenticate(secret, now); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := au.userRepository.FindOneByID(au.db, apiKey.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, entity.ErrInvalidCredential
+		}
+		return nil, nil, err
+	}
+	if user.IsDisabled {
+		return nil, nil, entity.ErrUserDisabled
+	}
+
+	if apiKey.Touch(now) {
+		if apiKey, err = au.apiKeyRepository.Update(au.db, apiKey); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return convertToUserDTO(user), au.convertToAPIKeyDTO(apiKey, ""), nil
+}
+
+func (au *apiKeyUsecase) convertToAPIKeyDTO(apiKey *entity.APIKey, key string) *dto.APIKeyDTO {
+	return dto.NewAPIKeyDTO(apiKey.ID, apiKey.UserID, apiKey.Name, key, apiKey.Scope.String(), apiKey.FolderID, apiKey.ExpiresAt, apiKey.LastUsedAt, apiKey.CreatedAt, apiKey.UpdatedAt)
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	apiKeyRepository := mock_repository.NewMockAPIKeyRepository(ctrl)
+	apiKeyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, apiKey *entity.APIKey) (*entity.APIKey, error) {
+		apiKey.ID = 1
+		return apiKey, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionWrite, nil)
+
+	au := NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+
+	result, err := au.Create("backup", "read", &folderInfo.ID, time.Now().Add(time.Hour), entity.Principal{UserID: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Scope != "read" || !entity.IsAPIKey(result.Key) {
+		t.Error("failed to create api key")
+	}
+}
+
+func TestCreateAPIKeyByAPIKey(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := mock_repository.NewMockAPIKeyRepository(ctrl)
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	au := NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+
+	principal := entity.Principal{UserID: 1, APIKeyID: 1, Scope: entity.PermissionWrite}
+	if _, err := au.Create("backup", "read", nil, time.Now().Add(time.Hour), principal); !errors.Is(err, entity.ErrPermissionDenied) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRemoveAPIKeyOfOtherUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	apiKey, _, err := entity.NewAPIKey(2, "backup", "read", nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	apiKey.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	apiKeyRepository := mock_repository.NewMockAPIKeyRepository(ctrl)
+	apiKeyRepository.EXPECT().FindOneByID(gomock.Any(), apiKey.ID).Return(apiKey, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	au := NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+
+	if err := au.Remove(apiKey.ID, entity.Principal{UserID: 1}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	apiKey, key, err := entity.NewAPIKey(user.ID, "backup", "write", nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	apiKey.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := mock_repository.NewMockAPIKeyRepository(ctrl)
+	apiKeyRepository.EXPECT().FindOneByPrefix(db, apiKey.Prefix).Return(apiKey, nil)
+	apiKeyRepository.EXPECT().Update(db, apiKey).Return(apiKey, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(db, user.ID).Return(user, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	au := NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+
+	authenticated, result, err := au.Authenticate(key)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if authenticated.ID != user.ID || result.Scope != "write" || result.LastUsedAt == nil {
+		t.Error("failed to authenticate api key")
+	}
+}
+
+func TestAuthenticateExpiredAPIKey(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	apiKey, key, err := entity.NewAPIKey(1, "backup", "read", nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	apiKey.ExpiresAt = time.Now().Add(-time.Hour)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepository := mock_repository.NewMockAPIKeyRepository(ctrl)
+	apiKeyRepository.EXPECT().FindOneByPrefix(db, apiKey.Prefix).Return(apiKey, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	au := NewAPIKeyUsecase(db, apiKeyRepository, folderInfoRepository, userRepository, accessControlService)
+
+	if _, _, err := au.Authenticate(key); !errors.Is(err, entity.ErrAPIKeyExpired) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type archiveEntry struct {
+	name  string
+	isDir bool
+	body  io.Reader
+}
+
+type archiveWalker struct {
+	maxEntries int
+	maxSize    int64
+	entries    int
+	size       int64
+	err        error
+}
+
+func walkArchive(body io.ReadSeeker, maxEntries int, maxSize int64, fn func(*archiveEntry) error) error {
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	format, err := entity.DetectArchiveFormat(head[:n])
+	if err != nil {
+		return err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	w := &archiveWalker{maxEntries: maxEntries, maxSize: maxSize}
+	switch format {
+	case entity.ArchiveZip:
+		return w.walkZip(body, fn)
+	case entity.ArchiveTarGzip:
+		r, err := gzip.NewReader(body)
+		if err != nil {
+			return invalidArchive(err)
+		}
+		defer r.Close()
+		return w.walkTar(r, fn)
+	case entity.ArchiveTarZstd:
+		r, err := zstd.NewReader(body, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(64<<20))
+		if err != nil {
+			return invalidArchive(err)
+		}
+		defer r.Close()
+		return w.walkTar(r, fn)
+	}
+	return w.walkTar(body, fn)
+}
+
+func (w *archiveWalker) walkZip(body io.ReadSeeker, fn func(*archiveEntry) error) error {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	ra, ok := body.(io.ReaderAt)
+	if !ok {
+		ra = &seekReaderAt{body}
+	}
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		return invalidArchive(err)
+	}
+
+	for _, v := range zr.File {
+		mode := v.Mode()
+		if !mode.IsDir() && !mode.IsRegular() {
+			continue
+		}
+		if err := w.walkZipFile(v, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *archiveWalker) walkZipFile(file *zip.File, fn func(*archiveEntry) error) error {
+	if file.Mode().IsDir() {
+		return w.visit(&archiveEntry{name: file.Name, isDir: true}, fn)
+	}
+
+	r, err := file.Open()
+	if err != nil {
+		return invalidArchive(err)
+	}
+	defer r.Close()
+	return w.visit(&archiveEntry{name: file.Name, body: r}, fn)
+}
+
+func (w *archiveWalker) walkTar(body io.Reader, fn func(*archiveEntry) error) error {
+	tr := tar.NewReader(body)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return invalidArchive(err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = w.visit(&archiveEntry{name: header.Name, isDir: true}, fn)
+		case tar.TypeReg:
+			err = w.visit(&archiveEntry{name: header.Name, body: tr}, fn)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (w *archiveWalker) visit(entry *archiveEntry, fn func(*archiveEntry) error) error {
+	w.entries++
+	if 0 < w.maxEntries && w.maxEntries < w.entries {
+		return entity.ErrArchiveTooLarge
+	}
+	if entry.body != nil {
+		entry.body = &archiveBody{r: entry.body, walker: w}
+	}
+
+	err := fn(entry)
+	if err != nil && w.err != nil {
+		if errors.Is(w.err, entity.ErrArchiveTooLarge) {
+			return w.err
+		}
+		return invalidArchive(w.err)
+	}
+	return err
+}
+
+type archiveBody struct {
+	r      io.Reader
+	walker *archiveWalker
+}
+
+func (b *archiveBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.walker.size += int64(n)
+	if 0 < b.walker.maxSize && b.walker.maxSize < b.walker.size {
+		err = entity.ErrArchiveTooLarge
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		b.walker.err = err
+	}
+	return n, err
+}
+
+type seekReaderAt struct {
+	io.ReadSeeker
+}
+
+func (r *seekReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := r.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(r.ReadSeeker, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func invalidArchive(err error) error {
+	return fmt.Errorf("%w: %w", entity.ErrInvalidArchive, err)
+}
+
+type archiveWriter interface {
+	CreateFolder(string, time.Time) error
+	CreateFile(string, time.Time, io.ReadSeeker) error
+	Close() error
+}
+
+func newArchiveWriter(w io.Writer, format entity.ArchiveFormat) archiveWriter {
+	if format == entity.ArchiveTarGzip {
+		gw := gzip.NewWriter(w)
+		return &tarArchiveWriter{tw: tar.NewWriter(gw), closer: gw}
+	}
+	return &zipArchiveWriter{zw: zip.NewWriter(w)}
+}
+
+type zipArchiveWriter struct {
+	zw *zip.Writer
+}
+
+func (w *zipArchiveWriter) CreateFolder(name string, modified time.Time) error {
+	header := &zip.FileHeader{
+		Name:     name,
+		Modified: modified,
+	}
+	header.SetMode(fs.ModeDir | fs.ModePerm)
+	_, err := w.zw.CreateHeader(header)
+	return err
+}
+
+func (w *zipArchiveWriter) CreateFile(name string, modified time.Time, body io.ReadSeeker) error {
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	}
+	header.SetMode(fs.ModePerm)
+	f, err := w.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, body)
+	return err
+}
+
+func (w *zipArchiveWriter) Close() error {
+	return w.zw.Close()
+}
+
+type tarArchiveWriter struct {
+	tw     *tar.Writer
+	closer io.Closer
+}
+
+func (w *tarArchiveWriter) CreateFolder(name string, modified time.Time) error {
+	return w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     name,
+		Mode:     int64(fs.ModePerm),
+		ModTime:  modified,
+	})
+}
+
+func (w *tarArchiveWriter) CreateFile(name string, modified time.Time, body io.ReadSeeker) error {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     int64(fs.ModePerm),
+		Size:     size,
+		ModTime:  modified,
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(w.tw, body)
+	return err
+}
+
+func (w *tarArchiveWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.closer.Close()
+}
@@ -1,49 +1,225 @@
 package usecase
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
 	"file-server/internal/app/api/usecase/dto"
 	"file-server/internal/pkg/config"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
 type AuthUsecase interface {
-	Signin(string) (*dto.AuthDTO, error)
+	Signin(string, string) (*dto.AuthDTO, error)
+	Refresh(string) (*dto.AuthDTO, error)
+	Signout(string, string) error
+	Authenticate(string) (*dto.UserDTO, error)
+	RemoveExpired(time.Time) error
 }
 
 type authUsecase struct {
-	db                   *gorm.DB
-	credentialRepository repository.CredentialRepository
+	db                     *gorm.DB
+	userRepository         repository.UserRepository
+	revokedTokenRepository repository.RevokedTokenRepository
 }
 
-func NewAuthUsecase(db *gorm.DB, credentialRepository repository.CredentialRepository) AuthUsecase {
+type authClaims struct {
+	ID        string
+	UserID    uint64
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+func NewAuthUsecase(db *gorm.DB, userRepository repository.UserRepository, revokedTokenRepository repository.RevokedTokenRepository) AuthUsecase {
 	return &authUsecase{
-		db:                   db,
-		credentialRepository: credentialRepository,
+		db:                     db,
+		userRepository:         userRepository,
+		revokedTokenRepository: revokedTokenRepository,
+	}
+}
+
+func (au *authUsecase) Signin(name string, password string) (*dto.AuthDTO, error) {
+	user, err := au.userRepository.FindOneByName(au.db, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvalidCredential
+		}
+		return nil, err
+	}
+
+	if err := user.Authenticate(password); err != nil {
+		return nil, err
+	}
+
+	return au.issue(user.ID)
+}
+
+func (au *authUsecase) Refresh(token string) (*dto.AuthDTO, error) {
+	var result *dto.AuthDTO
+	err := au.db.Transaction(func(tx *gorm.DB) error {
+		claims, err := au.parse(tx, token, entity.TokenTypeRefresh)
+		if err != nil {
+			return err
+		}
+
+		user, err := au.findUser(tx, claims)
+		if err != nil {
+			return err
+		}
+
+		if _, err := au.revokedTokenRepository.Create(tx, entity.NewRevokedToken(claims.ID, claims.UserID, claims.ExpiresAt)); err != nil {
+			return err
+		}
+
+		result, err = au.issue(user.ID)
+		return err
+	})
+	return result, err
+}
+
+func (au *authUsecase) Signout(accessToken string, refreshToken string) error {
+	return au.db.Transaction(func(tx *gorm.DB) error {
+		access, err := au.parse(tx, accessToken, entity.TokenTypeAccess)
+		if err != nil {
+			return err
+		}
+		if _, err := au.revokedTokenRepository.Create(tx, entity.NewRevokedToken(access.ID, access.UserID, access.ExpiresAt)); err != nil {
+			return err
+		}
+
+		if refreshToken == "" {
+			return nil
+		}
+		refresh, err := au.parse(tx, refreshToken, entity.TokenTypeRefresh)
+		if err != nil {
+			if errors.Is(err, entity.ErrTokenRevoked) {
+				return nil
+			}
+			return err
+		}
+		if refresh.UserID != access.UserID {
+			return entity.ErrInvalidToken
+		}
+		_, err = au.revokedTokenRepository.Create(tx, entity.NewRevokedToken(refresh.ID, refresh.UserID, refresh.ExpiresAt))
+		return err
+	})
+}
+
+func (au *authUsecase) Authenticate(token string) (*dto.UserDTO, error) {
+	claims, err := au.parse(au.db, token, entity.TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := au.findUser(au.db, claims)
+	if err != nil {
+		return nil, err
 	}
+
+	return convertToUserDTO(user), nil
+}
+
+func (au *authUsecase) RemoveExpired(expiresAt time.Time) error {
+	return au.revokedTokenRepository.RemoveByExpiresAtBefore(au.db, expiresAt)
 }
 
-func (au authUsecase) Signin(password string) (*dto.AuthDTO, error) {
-	credential, err := au.credentialRepository.FindOne(au.db)
+func (au *authUsecase) findUser(db *gorm.DB, claims *authClaims) (*entity.User, error) {
+	user, err := au.userRepository.FindOneByID(db, claims.UserID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvalidCredential
+		}
 		return nil, err
 	}
+	if user.IsDisabled {
+		return nil, entity.ErrUserDisabled
+	}
+	if !user.IsTokenValid(claims.IssuedAt) {
+		return nil, entity.ErrTokenRevoked
+	}
+	return user, nil
+}
+
+func (au *authUsecase) issue(userID uint64) (*dto.AuthDTO, error) {
+	now := time.Now()
 
-	if err := bcrypt.CompareHashAndPassword([]byte(credential.GetPassword()), []byte(password)); err != nil {
+	accessToken, err := au.sign(entity.TokenTypeAccess, userID, now, now.Add(config.ACCESS_TOKEN_EXPIRATION))
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := au.sign(entity.TokenTypeRefresh, userID, now, now.Add(config.REFRESH_TOKEN_EXPIRATION))
+	if err != nil {
 		return nil, err
 	}
 
+	return dto.NewAuthDTO(accessToken, refreshToken, config.ACCESS_TOKEN_EXPIRATION), nil
+}
+
+func (au *authUsecase) sign(tokenType entity.TokenType, userID uint64, issuedAt time.Time, expiresAt time.Time) (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
 	claims := jwt.MapClaims{
-		"exp": time.Now().Add(time.Hour * 1).Unix(),
+		"jti": hex.EncodeToString(id),
+		"sub": strconv.FormatUint(userID, 10),
+		"typ": string(tokenType),
+		"iat": issuedAt.Unix(),
+		"exp": expiresAt.Unix(),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS512, claims).SignedString([]byte(config.JWT_SECRET_KEY))
+}
+
+func (au *authUsecase) parse(db *gorm.DB, token string, tokenType entity.TokenType) (*authClaims, error) {
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(config.JWT_SECRET_KEY), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS512.Alg()}), jwt.WithIssuedAt(), jwt.WithExpirationRequired()); err != nil {
+		return nil, fmt.Errorf("%w: %w", entity.ErrInvalidToken, err)
+	}
+
+	if typ, ok := claims["typ"].(string); !ok || entity.TokenType(typ) != tokenType {
+		return nil, entity.ErrInvalidToken
+	}
+	id, ok := claims["jti"].(string)
+	if !ok || id == "" {
+		return nil, entity.ErrInvalidToken
 	}
-	token, err := jwt.NewWithClaims(jwt.SigningMethodHS512, claims).SignedString([]byte(config.JWT_SECRET_KEY))
+	subject, err := claims.GetSubject()
 	if err != nil {
+		return nil, entity.ErrInvalidToken
+	}
+	userID, err := strconv.ParseUint(subject, 10, 64)
+	if err != nil {
+		return nil, entity.ErrInvalidToken
+	}
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return nil, entity.ErrInvalidToken
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, entity.ErrInvalidToken
+	}
+
+	if _, err := au.revokedTokenRepository.FindOneByID(db, id); err == nil {
+		return nil, entity.ErrTokenRevoked
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
 
-	return dto.NewAuthDTO(token), nil
+	return &authClaims{
+		ID:        id,
+		UserID:    userID,
+		IssuedAt:  issuedAt.Time,
+		ExpiresAt: expiresAt.Time,
+	}, nil
 }
@@ -1,40 +1,399 @@
 package usecase
 
 import (
+	"errors"
 	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/config"
 	"file-server/test/database"
 	mock_repository "file-server/test/mock/domain/repository"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
-	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 func TestSignin(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+	repo.EXPECT().FindOneByID(db, user.ID).Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(db, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	result, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.RefreshToken == "" || result.ExpiresIn != time.Hour {
+		t.Error("failed to issue token pair")
+	}
+
+	authenticated, err := au.Authenticate(result.Token)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if authenticated.ID != user.ID || authenticated.Name != "name" {
+		t.Error("failed to authenticate")
+	}
+}
+
+func TestSigninWithInvalidPassword(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	if _, err := au.Signin("name", "invalid password"); !errors.Is(err, entity.ErrInvalidCredential) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthenticateDisabledUser(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(db, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	result, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	user.IsDisabled = true
+	repo.EXPECT().FindOneByID(db, user.ID).Return(user, nil)
+
+	if _, err := au.Authenticate(result.Token); !errors.Is(err, entity.ErrUserDisabled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthenticateWithRefreshToken(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	result, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := au.Authenticate(result.RefreshToken); !errors.Is(err, entity.ErrInvalidToken) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthenticateRevokedToken(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(db, gomock.Any()).Return(entity.NewRevokedToken("id", user.ID, time.Now()), nil)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	result, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := au.Authenticate(result.Token); !errors.Is(err, entity.ErrTokenRevoked) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthenticateTokenIssuedBeforeCutoff(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
 	db, _, err := database.Open()
 	if err != nil {
 		t.Error(err.Error())
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(db, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	result, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	user.RevokeTokens()
+	repo.EXPECT().FindOneByID(db, user.ID).Return(user, nil)
+
+	if _, err := au.Authenticate(result.Token); !errors.Is(err, entity.ErrTokenRevoked) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRefreshTokenIssuedBeforeCutoff(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	au := NewAuthUsecase(db, repo, rtr)
+	signin, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	user.RevokeTokens()
+	repo.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+
+	if _, err := au.Refresh(signin.RefreshToken); !errors.Is(err, entity.ErrTokenRevoked) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
+
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
 	if err != nil {
 		t.Error(err.Error())
 	}
-	credential := entity.NewCredential(string(hash))
+	user.ID = 1
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	repo := mock_repository.NewMockCredentialRepository(ctrl)
-	repo.EXPECT().FindOne(db).Return(credential, err)
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+	repo.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+	rtr.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, token *entity.RevokedToken) (*entity.RevokedToken, error) {
+		if token.UserID != user.ID || token.ExpiresAt.Before(time.Now().Add(time.Hour)) {
+			t.Error("failed to revoke refresh token")
+		}
+		return token, nil
+	})
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	au := NewAuthUsecase(db, repo, rtr)
+	signin, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	result, err := au.Refresh(signin.RefreshToken)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.Token == "" || result.RefreshToken == signin.RefreshToken {
+		t.Error("failed to refresh token")
+	}
+}
+
+func TestSignout(t *testing.T) {
+	config.ACCESS_TOKEN_EXPIRATION = time.Hour
+	config.REFRESH_TOKEN_EXPIRATION = 24 * time.Hour
 
-	au := NewAuthUsecase(db, repo)
-	result, err := au.Signin("password")
+	db, mock, err := database.Open()
 	if err != nil {
 		t.Error(err.Error())
 	}
 
-	if result == nil {
-		t.Error("failed to signin")
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindOneByName(db, "name").Return(user, nil)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrRecordNotFound).Times(2)
+	rtr.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, token *entity.RevokedToken) (*entity.RevokedToken, error) {
+		return token, nil
+	}).Times(2)
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	au := NewAuthUsecase(db, repo, rtr)
+	signin, err := au.Signin("name", "password")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := au.Signout(signin.Token, signin.RefreshToken); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestRemoveExpiredRevokedToken(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+
+	repo := mock_repository.NewMockUserRepository(ctrl)
+
+	rtr := mock_repository.NewMockRevokedTokenRepository(ctrl)
+	rtr.EXPECT().RemoveByExpiresAtBefore(db, now).Return(nil)
+
+	au := NewAuthUsecase(db, repo, rtr)
+	if err := au.RemoveExpired(now); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
 	}
 }
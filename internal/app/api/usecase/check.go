@@ -0,0 +1,411 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type CheckUsecase interface {
+	Check() ([]dto.InconsistencyDTO, error)
+	Repair(bool) ([]dto.InconsistencyDTO, error)
+}
+
+type checkUsecase struct {
+	db                    *gorm.DB
+	folderInfoRepository  repository.FolderInfoRepository
+	fileInfoRepository    repository.FileInfoRepository
+	fileVersionRepository repository.FileVersionRepository
+	folderBodyRepository  repository.FolderBodyRepository
+	fileBodyRepository    repository.FileBodyRepository
+	unitOfWork            service.UnitOfWork
+}
+
+func NewCheckUsecase(db *gorm.DB, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, fileVersionRepository repository.FileVersionRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository, unitOfWork service.UnitOfWork) CheckUsecase {
+	return &checkUsecase{
+		db:                    db,
+		folderInfoRepository:  folderInfoRepository,
+		fileInfoRepository:    fileInfoRepository,
+		fileVersionRepository: fileVersionRepository,
+		folderBodyRepository:  folderBodyRepository,
+		fileBodyRepository:    fileBodyRepository,
+		unitOfWork:            unitOfWork,
+	}
+}
+
+func (cu *checkUsecase) Check() ([]dto.InconsistencyDTO, error) {
+	inconsistencies, err := cu.check()
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.InconsistencyDTO, len(inconsistencies))
+	for i, v := range inconsistencies {
+		dtos[i] = *cu.convertToInconsistencyDTO(&v, false)
+	}
+	return dtos, nil
+}
+
+func (cu *checkUsecase) Repair(dryRun bool) ([]dto.InconsistencyDTO, error) {
+	inconsistencies, err := cu.check()
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.InconsistencyDTO, len(inconsistencies))
+	for i, v := range inconsistencies {
+		if dryRun {
+			dtos[i] = *cu.convertToInconsistencyDTO(&v, false)
+			continue
+		}
+		if err := cu.unitOfWork.Transaction(cu.db, func(tx *gorm.DB) error {
+			return cu.repair(tx, &v)
+		}); err != nil {
+			v.Reason = err.Error()
+			dtos[i] = *cu.convertToInconsistencyDTO(&v, false)
+			continue
+		}
+		dtos[i] = *cu.convertToInconsistencyDTO(&v, true)
+	}
+	return dtos, nil
+}
+
+func (cu *checkUsecase) check() ([]entity.Inconsistency, error) {
+	folders, err := cu.folderInfoRepository.FindAll(cu.db)
+	if err != nil {
+		return nil, err
+	}
+	files, err := cu.fileInfoRepository.FindAll(cu.db)
+	if err != nil {
+		return nil, err
+	}
+	fileVersions, err := cu.fileVersionRepository.FindAll(cu.db)
+	if err != nil {
+		return nil, err
+	}
+
+	bodies := make(map[string]bool)
+	fileBodies, err := cu.fileBodyRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range fileBodies {
+		bodies[v] = true
+	}
+	hasFolderBodies := true
+	folderBodies, err := cu.folderBodyRepository.FindAll()
+	if errors.Is(err, errors.ErrUnsupported) {
+		hasFolderBodies = false
+	} else if err != nil {
+		return nil, err
+	}
+	for _, v := range folderBodies {
+		bodies[v] = true
+	}
+
+	expectedPaths := cu.expectedFolderPaths(folders)
+	sort.SliceStable(folders, func(i, j int) bool {
+		return expectedPaths[folders[i].ID] < expectedPaths[folders[j].ID]
+	})
+
+	inconsistencies := []entity.Inconsistency{}
+	known := make(map[string]bool)
+	for _, v := range folders {
+		expectedPath := expectedPaths[v.ID]
+		known[expectedPath] = true
+		if v.IsRoot() {
+			continue
+		}
+
+		inconsistency := cu.inspect(entity.InconsistencyMissingFolder, v.ID, v.Path.Value, expectedPath, bodies, hasFolderBodies)
+		if inconsistency == nil {
+			continue
+		}
+		if inconsistency.Kind == entity.InconsistencyWrongPath && inconsistency.BodyPath == v.Path.Value {
+			cu.relocate(bodies, v.Path.Value, expectedPath)
+		}
+		inconsistencies = append(inconsistencies, *inconsistency)
+	}
+
+	for _, v := range files {
+		expectedPath := v.Path.Value
+		if folderPath, ok := expectedPaths[v.FolderID]; ok {
+			expectedPath = folderPath + v.Name.Value
+		}
+		known[expectedPath] = true
+
+		inconsistency := cu.inspect(entity.InconsistencyMissingBody, v.ID, v.Path.Value, expectedPath, bodies, true)
+		if inconsistency == nil {
+			inconsistency, err = cu.inspectSize(v.ID, v.Path.Value, v.Size)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if inconsistency == nil {
+			continue
+		}
+		if inconsistency.Kind == entity.InconsistencyWrongPath && inconsistency.BodyPath == v.Path.Value {
+			cu.relocate(bodies, v.Path.Value, expectedPath)
+		}
+		inconsistencies = append(inconsistencies, *inconsistency)
+	}
+
+	for _, v := range fileVersions {
+		if !bodies[v.Path()] {
+			continue
+		}
+		inconsistency, err := cu.inspectSize(v.ID, v.Path(), v.Size)
+		if err != nil {
+			return nil, err
+		}
+		if inconsistency != nil {
+			inconsistencies = append(inconsistencies, *inconsistency)
+		}
+	}
+
+	orphans := []string{}
+	for k := range bodies {
+		if !known[k] && !entity.IsReservedPath(k) {
+			orphans = append(orphans, k)
+		}
+	}
+	sort.Strings(orphans)
+	for _, v := range orphans {
+		kind := entity.InconsistencyOrphanBody
+		if strings.HasSuffix(v, "/") {
+			kind = entity.InconsistencyOrphanFolder
+		}
+		inconsistencies = append(inconsistencies, *entity.NewInconsistency(kind, 0, v, "row not found"))
+	}
+	return inconsistencies, nil
+}
+
+func (cu *checkUsecase) inspect(kind entity.InconsistencyKind, id uint64, path string, expectedPath string, bodies map[string]bool, hasBodies bool) *entity.Inconsistency {
+	if path == expectedPath {
+		if bodies[path] || !hasBodies {
+			return nil
+		}
+		return entity.NewInconsistency(kind, id, path, "body not found")
+	}
+
+	inconsistency := entity.NewInconsistency(entity.InconsistencyWrongPath, id, path, "path does not match the parent folder")
+	inconsistency.ExpectedPath = expectedPath
+	switch {
+	case bodies[expectedPath]:
+		inconsistency.BodyPath = expectedPath
+	case bodies[path] || !hasBodies:
+		inconsistency.BodyPath = path
+	default:
+		inconsistency.Kind = kind
+		inconsistency.Reason = "body not found"
+	}
+	return inconsistency
+}
+
+func (cu *checkUsecase) inspectSize(id uint64, path string, size int64) (*entity.Inconsistency, error) {
+	bodySize, err := cu.bodySize(path)
+	if err != nil {
+		return nil, err
+	}
+	if bodySize == size {
+		return nil, nil
+	}
+	return entity.NewInconsistency(entity.InconsistencySizeMismatch, id, path, fmt.Sprintf("size %d does not match the body size %d", size, bodySize)), nil
+}
+
+func (cu *checkUsecase) bodySize(path string) (int64, error) {
+	body, err := cu.fileBodyRepository.Read(path)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+	return body.Seek(0, io.SeekEnd)
+}
+
+func (cu *checkUsecase) relocate(bodies map[string]bool, oldPath string, newPath string) {
+	moved := []string{}
+	for k := range bodies {
+		if k == oldPath || (strings.HasSuffix(oldPath, "/") && strings.HasPrefix(k, oldPath)) {
+			moved = append(moved, k)
+		}
+	}
+	for _, v := range moved {
+		delete(bodies, v)
+	}
+	for _, v := range moved {
+		bodies[newPath+strings.TrimPrefix(v, oldPath)] = true
+	}
+}
+
+func (cu *checkUsecase) expectedFolderPaths(folders []entity.FolderInfo) map[uint64]string {
+	byID := make(map[uint64]*entity.FolderInfo, len(folders))
+	for i := range folders {
+		byID[folders[i].ID] = &folders[i]
+	}
+
+	paths := make(map[uint64]string, len(folders))
+	var resolve func(*entity.FolderInfo, int) string
+	resolve = func(folder *entity.FolderInfo, depth int) string {
+		if path, ok := paths[folder.ID]; ok {
+			return path
+		}
+		path := folder.Path.Value
+		if !folder.IsRoot() && depth < len(folders) {
+			if parent, ok := byID[*folder.ParentFolderID]; ok {
+				path = resolve(parent, depth+1) + folder.Name.Value + "/"
+			}
+		}
+		paths[folder.ID] = path
+		return path
+	}
+	for i := range folders {
+		resolve(&folders[i], 0)
+	}
+	return paths
+}
+
+func (cu *checkUsecase) repair(db *gorm.DB, inconsistency *entity.Inconsistency) error {
+	switch inconsistency.Action() {
+	case entity.RepairRemoveRow:
+		fileInfo, err := cu.fileInfoRepository.FindOneByID(db, inconsistency.ID)
+		if err != nil {
+			return err
+		}
+		return cu.fileInfoRepository.Remove(db, fileInfo)
+	case entity.RepairCreateBody:
+		folderInfo, err := cu.updateFolderPath(db, inconsistency)
+		if err != nil {
+			return err
+		}
+		return cu.folderBodyRepository.Create(db, entity.NewFolderBody(folderInfo.Path.Value))
+	case entity.RepairUpdatePath:
+		if inconsistency.IsFolder() {
+			if _, err := cu.updateFolderPath(db, inconsistency); err != nil {
+				return err
+			}
+			if inconsistency.BodyPath == inconsistency.ExpectedPath {
+				return nil
+			}
+			return cu.folderBodyRepository.Update(db, inconsistency.BodyPath, inconsistency.ExpectedPath)
+		}
+
+		fileInfo, err := cu.fileInfoRepository.FindOneByID(db, inconsistency.ID)
+		if err != nil {
+			return err
+		}
+		if err := fileInfo.SetPath(inconsistency.ExpectedPath); err != nil {
+			return err
+		}
+		if _, err := cu.fileInfoRepository.Update(db, fileInfo); err != nil {
+			return err
+		}
+		if inconsistency.BodyPath == inconsistency.ExpectedPath {
+			return nil
+		}
+		return cu.fileBodyRepository.Update(db, inconsistency.BodyPath, inconsistency.ExpectedPath)
+	case entity.RepairUpdateSize:
+		size, err := cu.bodySize(inconsistency.Path)
+		if err != nil {
+			return err
+		}
+		if entity.IsFileVersionPath(inconsistency.Path) {
+			fileVersion, err := cu.fileVersionRepository.FindOneByID(db, inconsistency.ID)
+			if err != nil {
+				return err
+			}
+			fileVersion.Size = size
+			_, err = cu.fileVersionRepository.Update(db, fileVersion)
+			return err
+		}
+
+		fileInfo, err := cu.fileInfoRepository.FindOneByID(db, inconsistency.ID)
+		if err != nil {
+			return err
+		}
+		fileInfo.Size = size
+		_, err = cu.fileInfoRepository.Update(db, fileInfo)
+		return err
+	case entity.RepairImport:
+		if inconsistency.IsFolder() {
+			_, err := cu.importFolder(db, inconsistency.Path)
+			return err
+		}
+		return cu.importFile(db, inconsistency.Path)
+	}
+	return nil
+}
+
+func (cu *checkUsecase) updateFolderPath(db *gorm.DB, inconsistency *entity.Inconsistency) (*entity.FolderInfo, error) {
+	folderInfo, err := cu.folderInfoRepository.FindOneByID(db, inconsistency.ID)
+	if err != nil {
+		return nil, err
+	}
+	if inconsistency.ExpectedPath == "" {
+		return folderInfo, nil
+	}
+	if err := folderInfo.SetPath(inconsistency.ExpectedPath); err != nil {
+		return nil, err
+	}
+	return cu.folderInfoRepository.Update(db, folderInfo)
+}
+
+func (cu *checkUsecase) importFolder(db *gorm.DB, path string) (*entity.FolderInfo, error) {
+	folderInfo, err := cu.folderInfoRepository.FindOneByPath(db, path)
+	if err == nil || path == "/" || !errors.Is(err, gorm.ErrRecordNotFound) {
+		return folderInfo, err
+	}
+
+	parentFolder, err := cu.importFolder(db, entity.ParentPath(path))
+	if err != nil {
+		return nil, err
+	}
+	folderInfo, err = entity.NewFolderInfo(&parentFolder.ID, entity.BaseName(path), path, false)
+	if err != nil {
+		return nil, err
+	}
+	folderInfo.OwnerID = parentFolder.OwnerID
+	return cu.folderInfoRepository.Create(db, folderInfo)
+}
+
+func (cu *checkUsecase) importFile(db *gorm.DB, path string) error {
+	parentFolder, err := cu.importFolder(db, entity.ParentPath(path))
+	if err != nil {
+		return err
+	}
+
+	body, err := cu.fileBodyRepository.Read(path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	mimeType, _, err := detectMimeType(body)
+	if err != nil {
+		return err
+	}
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := entity.NewFileInfo(parentFolder.ID, entity.BaseName(path), path, mimeType, false)
+	if err != nil {
+		return err
+	}
+	fileInfo.OwnerID = parentFolder.OwnerID
+	fileInfo.Size = size
+	_, err = cu.fileInfoRepository.Create(db, fileInfo)
+	return err
+}
+
+func (cu *checkUsecase) convertToInconsistencyDTO(inconsistency *entity.Inconsistency, repaired bool) *dto.InconsistencyDTO {
+	return dto.NewInconsistencyDTO(string(inconsistency.Kind), inconsistency.ID, inconsistency.Path, inconsistency.ExpectedPath, inconsistency.Reason, string(inconsistency.Action()), repaired)
+}
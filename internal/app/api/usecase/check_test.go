@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCheck(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+	parentFolderID := root.ID
+	moved, err := entity.NewFolderInfo(&parentFolderID, "docs", "/old/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	moved.ID = 2
+
+	found, err := entity.NewFileInfo(2, "found.txt", "/old/found.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	found.ID = 1
+	missing, err := entity.NewFileInfo(1, "missing.txt", "/missing.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	missing.ID = 2
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FolderInfo{*root, *moved}, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FileInfo{*found, *missing}, nil)
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().FindAll().Return([]string{"/old/", "/:trash/", "/:trash/1/"}, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().FindAll().Return([]string{"/old/found.txt", "/orphan.txt", "/:trash/1/removed.txt"}, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+
+	cu := NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
+
+	result, err := cu.Check()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 4 {
+		t.Fatalf("failed to check consistency: %+v", result)
+	}
+	if result[0].Kind != "wrong_path" || result[0].ID != moved.ID || result[0].ExpectedPath != "/docs/" {
+		t.Errorf("failed to detect wrong folder path: %+v", result[0])
+	}
+	if result[1].Kind != "wrong_path" || result[1].ID != found.ID || result[1].ExpectedPath != "/docs/found.txt" {
+		t.Errorf("failed to detect wrong file path: %+v", result[1])
+	}
+	if result[2].Kind != "missing_body" || result[2].ID != missing.ID || result[2].Action != "remove_row" {
+		t.Errorf("failed to detect missing body: %+v", result[2])
+	}
+	if result[3].Kind != "orphan_body" || result[3].Path != "/orphan.txt" || result[3].Action != "import" {
+		t.Errorf("failed to detect orphan body: %+v", result[3])
+	}
+}
+
+func TestRepair(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	ownerID := uint64(1)
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+	root.OwnerID = &ownerID
+
+	missing, err := entity.NewFileInfo(1, "missing.txt", "/missing.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	missing.ID = 1
+
+	path := t.TempDir() + "/orphan.txt"
+	if err := os.WriteFile(path, []byte("orphan"), 0644); err != nil {
+		t.Error(err.Error())
+	}
+	body, err := os.Open(path)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FolderInfo{*root}, nil)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FileInfo{*missing}, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), missing.ID).Return(missing, nil)
+	fileInfoRepository.EXPECT().Remove(gomock.Any(), missing).Return(nil)
+	fileInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		if file.FolderID != root.ID || file.Path.Value != "/orphan.txt" || file.Size != 6 || file.MimeType.Value != "text/plain; charset=utf-8" || file.OwnerID != root.OwnerID {
+			t.Errorf("failed to import orphan body: %+v", file)
+		}
+		return file, nil
+	})
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().FindAll().Return(nil, errors.ErrUnsupported)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().FindAll().Return([]string{"/orphan.txt"}, nil)
+	fileBodyRepository.EXPECT().Read("/orphan.txt").Return(body, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	cu := NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
+
+	result, err := cu.Repair(false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || !result[0].Repaired || !result[1].Repaired {
+		t.Errorf("failed to repair inconsistencies: %+v", result)
+	}
+}
+
+func TestRepairDryRun(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().FindAll().Return([]string{"/orphan/"}, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().FindAll().Return([]string{"/orphan/file.txt"}, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+
+	cu := NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
+
+	result, err := cu.Repair(true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[0].Kind != "orphan_folder" || result[1].Kind != "orphan_body" || result[0].Repaired || result[1].Repaired {
+		t.Errorf("failed to preview repairs: %+v", result)
+	}
+}
+
+func TestCheckSizeMismatch(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	file, err := entity.NewFileInfo(1, "file.txt", "/file.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file.ID = 1
+	sized, err := entity.NewFileInfo(1, "sized.txt", "/sized.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sized.ID = 2
+	sized.Size = 5
+	fileVersion := &entity.FileVersion{ID: 3, FileID: file.ID, MimeType: "text/plain"}
+
+	dir := t.TempDir()
+	for name, body := range map[string]string{"file": "file body", "sized": "sized", "version": "old"} {
+		if err := os.WriteFile(dir+"/"+name, []byte(body), 0644); err != nil {
+			t.Error(err.Error())
+		}
+	}
+	open := func(name string) func(string) (io.ReadSeekCloser, error) {
+		return func(string) (io.ReadSeekCloser, error) {
+			return os.Open(dir + "/" + name)
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FolderInfo{*root}, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FileInfo{*file, *sized}, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), file.ID).Return(file, nil)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		if file.Size != 9 {
+			t.Errorf("failed to update file size: %+v", file)
+		}
+		return file, nil
+	})
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.FileVersion{*fileVersion}, nil)
+	fileVersionRepository.EXPECT().FindOneByID(gomock.Any(), fileVersion.ID).Return(fileVersion, nil)
+	fileVersionRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fileVersion *entity.FileVersion) (*entity.FileVersion, error) {
+		if fileVersion.Size != 3 {
+			t.Errorf("failed to update file version size: %+v", fileVersion)
+		}
+		return fileVersion, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().FindAll().Return(nil, errors.ErrUnsupported)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().FindAll().Return([]string{"/file.txt", "/sized.txt", fileVersion.Path()}, nil)
+	fileBodyRepository.EXPECT().Read("/file.txt").DoAndReturn(open("file")).Times(2)
+	fileBodyRepository.EXPECT().Read("/sized.txt").DoAndReturn(open("sized"))
+	fileBodyRepository.EXPECT().Read(fileVersion.Path()).DoAndReturn(open("version")).Times(2)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	cu := NewCheckUsecase(db, folderInfoRepository, fileInfoRepository, fileVersionRepository, folderBodyRepository, fileBodyRepository, unitOfWork)
+
+	result, err := cu.Repair(false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("failed to check sizes: %+v", result)
+	}
+	if result[0].Kind != "size_mismatch" || result[0].ID != file.ID || result[0].Action != "update_size" || !result[0].Repaired {
+		t.Errorf("failed to repair file size: %+v", result[0])
+	}
+	if result[1].Kind != "size_mismatch" || result[1].ID != fileVersion.ID || result[1].Path != fileVersion.Path() || !result[1].Repaired {
+		t.Errorf("failed to repair file version size: %+v", result[1])
+	}
+}
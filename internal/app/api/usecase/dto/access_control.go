@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type AccessControlDTO struct {
+	ID         uint64
+	FolderID   uint64
+	UserID     uint64
+	Permission string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewAccessControlDTO(id uint64, folderID uint64, userID uint64, permission string, createdAt time.Time, updatedAt time.Time) *AccessControlDTO {
+	return &AccessControlDTO{
+		ID:         id,
+		FolderID:   folderID,
+		UserID:     userID,
+		Permission: permission,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
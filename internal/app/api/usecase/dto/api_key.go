@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type APIKeyDTO struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Key        string
+	Scope      string
+	FolderID   *uint64
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewAPIKeyDTO(id uint64, userID uint64, name string, key string, scope string, folderID *uint64, expiresAt time.Time, lastUsedAt *time.Time, createdAt time.Time, updatedAt time.Time) *APIKeyDTO {
+	return &APIKeyDTO{
+		ID:         id,
+		UserID:     userID,
+		Name:       name,
+		Key:        key,
+		Scope:      scope,
+		FolderID:   folderID,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
@@ -1,11 +1,17 @@
 package dto
 
+import "time"
+
 type AuthDTO struct {
-	Token string
+	Token        string
+	RefreshToken string
+	ExpiresIn    time.Duration
 }
 
-func NewAuthDTO(token string) *AuthDTO {
+func NewAuthDTO(token string, refreshToken string, expiresIn time.Duration) *AuthDTO {
 	return &AuthDTO{
-		Token: token,
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
 	}
 }
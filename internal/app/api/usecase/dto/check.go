@@ -0,0 +1,23 @@
+package dto
+
+type InconsistencyDTO struct {
+	Kind         string
+	ID           uint64
+	Path         string
+	ExpectedPath string
+	Reason       string
+	Action       string
+	Repaired     bool
+}
+
+func NewInconsistencyDTO(kind string, id uint64, path string, expectedPath string, reason string, action string, repaired bool) *InconsistencyDTO {
+	return &InconsistencyDTO{
+		Kind:         kind,
+		ID:           id,
+		Path:         path,
+		ExpectedPath: expectedPath,
+		Reason:       reason,
+		Action:       action,
+		Repaired:     repaired,
+	}
+}
@@ -1,25 +1,32 @@
 package dto
 
-import "time"
+import (
+	"io"
+	"time"
+)
 
 type FileInfoDTO struct {
 	ID        uint64
 	FolderID  uint64
+	OwnerID   *uint64
 	Name      string
 	Path      string
 	MimeType  string
+	Size      int64
 	IsHide    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
-func NewFileInfoDTO(id uint64, folderID uint64, name string, path string, mimeType string, isHide bool, createdAt time.Time, updatedAt time.Time) *FileInfoDTO {
+func NewFileInfoDTO(id uint64, folderID uint64, ownerID *uint64, name string, path string, mimeType string, size int64, isHide bool, createdAt time.Time, updatedAt time.Time) *FileInfoDTO {
 	return &FileInfoDTO{
 		ID:        id,
 		FolderID:  folderID,
+		OwnerID:   ownerID,
 		Name:      name,
 		Path:      path,
 		MimeType:  mimeType,
+		Size:      size,
 		IsHide:    isHide,
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
@@ -27,13 +34,19 @@ func NewFileInfoDTO(id uint64, folderID uint64, name string, path string, mimeTy
 }
 
 type FileBodyDTO struct {
-	MimeType string
-	Body     []byte
+	Name      string
+	MimeType  string
+	Body      io.ReadSeekCloser
+	URL       string
+	UpdatedAt time.Time
 }
 
-func NewFileBodyDTO(mimeType string, body []byte) *FileBodyDTO {
+func NewFileBodyDTO(name string, mimeType string, body io.ReadSeekCloser, url string, updatedAt time.Time) *FileBodyDTO {
 	return &FileBodyDTO{
-		MimeType: mimeType,
-		Body:     body,
+		Name:      name,
+		MimeType:  mimeType,
+		Body:      body,
+		URL:       url,
+		UpdatedAt: updatedAt,
 	}
 }
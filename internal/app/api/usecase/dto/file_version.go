@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type FileVersionDTO struct {
+	ID        uint64
+	FileID    uint64
+	MimeType  string
+	CreatedAt time.Time
+}
+
+func NewFileVersionDTO(id uint64, fileID uint64, mimeType string, createdAt time.Time) *FileVersionDTO {
+	return &FileVersionDTO{
+		ID:        id,
+		FileID:    fileID,
+		MimeType:  mimeType,
+		CreatedAt: createdAt,
+	}
+}
@@ -1,10 +1,14 @@
 package dto
 
-import "time"
+import (
+	"io"
+	"time"
+)
 
 type FolderInfoDTO struct {
 	ID             uint64
 	ParentFolderID *uint64
+	OwnerID        *uint64
 	Name           string
 	Path           string
 	IsHide         bool
@@ -14,10 +18,11 @@ type FolderInfoDTO struct {
 	UpdatedAt      time.Time
 }
 
-func NewFolderInfoDTO(id uint64, parentFolderID *uint64, name string, path string, isHide bool, folders []FolderInfoDTO, files []FileInfoDTO, createdAt time.Time, updatedAt time.Time) *FolderInfoDTO {
+func NewFolderInfoDTO(id uint64, parentFolderID *uint64, ownerID *uint64, name string, path string, isHide bool, folders []FolderInfoDTO, files []FileInfoDTO, createdAt time.Time, updatedAt time.Time) *FolderInfoDTO {
 	return &FolderInfoDTO{
 		ID:             id,
 		ParentFolderID: parentFolderID,
+		OwnerID:        ownerID,
 		Name:           name,
 		Path:           path,
 		IsHide:         isHide,
@@ -29,13 +34,41 @@ func NewFolderInfoDTO(id uint64, parentFolderID *uint64, name string, path strin
 }
 
 type FolderBodyDTO struct {
+	Name     string
 	MimeType string
-	Body     []byte
+	Body     io.ReadCloser
 }
 
-func NewFolderBodyDTO(mimeType string, body []byte) *FolderBodyDTO {
+func NewFolderBodyDTO(name string, mimeType string, body io.ReadCloser) *FolderBodyDTO {
 	return &FolderBodyDTO{
+		Name:     name,
 		MimeType: mimeType,
 		Body:     body,
 	}
 }
+
+type FolderListDTO struct {
+	Folders    []FolderInfoDTO
+	Files      []FileInfoDTO
+	NextCursor string
+}
+
+func NewFolderListDTO(folders []FolderInfoDTO, files []FileInfoDTO, nextCursor string) *FolderListDTO {
+	return &FolderListDTO{
+		Folders:    folders,
+		Files:      files,
+		NextCursor: nextCursor,
+	}
+}
+
+type FolderCountDTO struct {
+	Folders int64
+	Files   int64
+}
+
+func NewFolderCountDTO(folders int64, files int64) *FolderCountDTO {
+	return &FolderCountDTO{
+		Folders: folders,
+		Files:   files,
+	}
+}
@@ -0,0 +1,31 @@
+package dto
+
+type ImportDTO struct {
+	CreatedFolders int
+	CreatedFiles   int
+	UpdatedFiles   int
+	UnchangedFiles int
+	Failures       []ImportFailureDTO
+}
+
+func NewImportDTO(createdFolders int, createdFiles int, updatedFiles int, unchangedFiles int, failures []ImportFailureDTO) *ImportDTO {
+	return &ImportDTO{
+		CreatedFolders: createdFolders,
+		CreatedFiles:   createdFiles,
+		UpdatedFiles:   updatedFiles,
+		UnchangedFiles: unchangedFiles,
+		Failures:       failures,
+	}
+}
+
+type ImportFailureDTO struct {
+	Path   string
+	Reason string
+}
+
+func NewImportFailureDTO(path string, reason string) *ImportFailureDTO {
+	return &ImportFailureDTO{
+		Path:   path,
+		Reason: reason,
+	}
+}
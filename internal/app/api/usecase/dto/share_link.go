@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type ShareLinkDTO struct {
+	ID            uint64
+	UserID        uint64
+	Token         string
+	FileID        *uint64
+	FolderID      *uint64
+	HasPassword   bool
+	ExpiresAt     *time.Time
+	MaxDownloads  *uint64
+	DownloadCount uint64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewShareLinkDTO(id uint64, userID uint64, token string, fileID *uint64, folderID *uint64, hasPassword bool, expiresAt *time.Time, maxDownloads *uint64, downloadCount uint64, createdAt time.Time, updatedAt time.Time) *ShareLinkDTO {
+	return &ShareLinkDTO{
+		ID:            id,
+		UserID:        userID,
+		Token:         token,
+		FileID:        fileID,
+		FolderID:      folderID,
+		HasPassword:   hasPassword,
+		ExpiresAt:     expiresAt,
+		MaxDownloads:  maxDownloads,
+		DownloadCount: downloadCount,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
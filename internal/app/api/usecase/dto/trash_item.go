@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type TrashItemDTO struct {
+	ID             uint64
+	UserID         *uint64
+	FolderID       *uint64
+	FileID         *uint64
+	ParentFolderID *uint64
+	Name           string
+	Path           string
+	CreatedAt      time.Time
+}
+
+func NewTrashItemDTO(id uint64, userID *uint64, folderID *uint64, fileID *uint64, parentFolderID *uint64, name string, path string, createdAt time.Time) *TrashItemDTO {
+	return &TrashItemDTO{
+		ID:             id,
+		UserID:         userID,
+		FolderID:       folderID,
+		FileID:         fileID,
+		ParentFolderID: parentFolderID,
+		Name:           name,
+		Path:           path,
+		CreatedAt:      createdAt,
+	}
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type UploadSessionDTO struct {
+	ID        string
+	FolderID  uint64
+	Name      string
+	Size      int64
+	Offset    int64
+	IsHide    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewUploadSessionDTO(id string, folderID uint64, name string, size int64, offset int64, isHide bool, createdAt time.Time, updatedAt time.Time) *UploadSessionDTO {
+	return &UploadSessionDTO{
+		ID:        id,
+		FolderID:  folderID,
+		Name:      name,
+		Size:      size,
+		Offset:    offset,
+		IsHide:    isHide,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type UserDTO struct {
+	ID         uint64
+	Name       string
+	IsAdmin    bool
+	IsDisabled bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewUserDTO(id uint64, name string, isAdmin bool, isDisabled bool, createdAt time.Time, updatedAt time.Time) *UserDTO {
+	return &UserDTO{
+		ID:         id,
+		Name:       name,
+		IsAdmin:    isAdmin,
+		IsDisabled: isDisabled,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
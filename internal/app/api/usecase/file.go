@@ -1,12 +1,15 @@
 package usecase
 
 import (
+	"bytes"
+	"errors"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
 	"file-server/internal/app/api/domain/service"
 	"file-server/internal/app/api/usecase/dto"
+	"file-server/internal/pkg/config"
 	"file-server/internal/pkg/types"
-	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -14,12 +17,14 @@ import (
 )
 
 type FileUsecase interface {
-	Create(uint64, bool, []types.File) ([]dto.FileInfoDTO, error)
-	Update(uint64, string, bool, bool) (*dto.FileInfoDTO, error)
-	Remove(uint64, bool) error
-	Move(uint64, uint64, bool) (*dto.FileInfoDTO, error)
-	Copy(uint64, uint64, bool) (*dto.FileInfoDTO, error)
-	Read(uint64, bool) (*dto.FileBodyDTO, error)
+	Create(uint64, bool, []types.File, entity.ConflictMode, entity.Principal) ([]dto.FileInfoDTO, error)
+	Update(uint64, string, bool, entity.Principal) (*dto.FileInfoDTO, error)
+	Remove(uint64, entity.Principal) error
+	Move(uint64, uint64, entity.ConflictMode, entity.Principal) (*dto.FileInfoDTO, error)
+	Copy(uint64, uint64, entity.ConflictMode, entity.Principal) (*dto.FileInfoDTO, error)
+	Read(uint64, entity.Principal) (*dto.FileBodyDTO, error)
+	Overwrite(uint64, io.Reader, entity.Principal) (*dto.FileInfoDTO, error)
+	Extract(uint64, uint64, entity.ConflictMode, entity.Principal) ([]dto.FileInfoDTO, error)
 }
 
 type fileUsecase struct {
@@ -27,51 +32,52 @@ type fileUsecase struct {
 	fileInfoRepository   repository.FileInfoRepository
 	fileBodyRepository   repository.FileBodyRepository
 	folderInfoRepository repository.FolderInfoRepository
+	folderBodyRepository repository.FolderBodyRepository
 	fileInfoService      service.FileInfoService
+	accessControlService service.AccessControlService
+	trashService         service.TrashService
+	fileVersionService   service.FileVersionService
+	unitOfWork           service.UnitOfWork
 }
 
-func NewFileUsecase(db *gorm.DB, fileInfoRepository repository.FileInfoRepository, fileBodyRepository repository.FileBodyRepository, folderInfoRepository repository.FolderInfoRepository, fileInfoService service.FileInfoService) FileUsecase {
+func NewFileUsecase(db *gorm.DB, fileInfoRepository repository.FileInfoRepository, fileBodyRepository repository.FileBodyRepository, folderInfoRepository repository.FolderInfoRepository, folderBodyRepository repository.FolderBodyRepository, fileInfoService service.FileInfoService, accessControlService service.AccessControlService, trashService service.TrashService, fileVersionService service.FileVersionService, unitOfWork service.UnitOfWork) FileUsecase {
 	return &fileUsecase{
 		db:                   db,
 		fileInfoRepository:   fileInfoRepository,
 		fileBodyRepository:   fileBodyRepository,
 		folderInfoRepository: folderInfoRepository,
+		folderBodyRepository: folderBodyRepository,
 		fileInfoService:      fileInfoService,
+		accessControlService: accessControlService,
+		trashService:         trashService,
+		fileVersionService:   fileVersionService,
+		unitOfWork:           unitOfWork,
 	}
 }
 
-func (fu *fileUsecase) Create(folderID uint64, isHide bool, files []types.File) ([]dto.FileInfoDTO, error) {
-	fileInfos := make([]entity.FileInfo, len(files))
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+func (fu *fileUsecase) Create(folderID uint64, isHide bool, files []types.File, conflict entity.ConflictMode, principal entity.Principal) ([]dto.FileInfoDTO, error) {
+	var fileInfos []entity.FileInfo
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		parentFolder, err := fu.folderInfoRepository.FindOneByID(tx, folderID)
 		if err != nil {
 			return err
 		}
 
-		for i, v := range files {
-			path := parentFolder.Path.Value + v.Name
-			mimeType := http.DetectContentType(v.Body)
+		if err := fu.authorizeFolder(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
 
-			fileInfo, err := entity.NewFileInfo(folderID, v.Name, path, mimeType, isHide)
+		for _, v := range files {
+			fileInfo, err := fu.create(tx, parentFolder, v.Name, v.Body, isHide, conflict, principal)
 			if err != nil {
 				return err
 			}
-			fileInfos[i] = *fileInfo
-
-			if isExists, err := fu.fileInfoService.IsExists(tx, fileInfo); err != nil {
-				return err
-			} else if isExists {
-				return fmt.Errorf("%s is already exists", fileInfo.Path.Value)
-			}
-
-			fileBody := entity.NewFileBody(path, v.Body)
-			if err := fu.fileBodyRepository.Create(fileBody); err != nil {
-				return err
+			if fileInfo != nil {
+				fileInfos = append(fileInfos, *fileInfo)
 			}
 		}
 
-		fileInfos, err = fu.fileInfoRepository.Creates(tx, fileInfos)
-		return err
+		return nil
 	}); err != nil {
 		return nil, err
 	}
@@ -83,19 +89,19 @@ func (fu *fileUsecase) Create(folderID uint64, isHide bool, files []types.File)
 	return dtos, nil
 }
 
-func (fu *fileUsecase) Update(id uint64, name string, isHide bool, isDisplayHiddenObject bool) (*dto.FileInfoDTO, error) {
+func (fu *fileUsecase) Update(id uint64, name string, isHide bool, principal entity.Principal) (*dto.FileInfoDTO, error) {
 	var fileInfo *entity.FileInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
-		} else {
-			fileInfo, err = fu.fileInfoRepository.FindOneByIDAndIsHide(tx, id, false)
-		}
+		fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
 		if err != nil {
 			return err
 		}
 
+		if err := fu.authorize(tx, principal, fileInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
 		fileInfo.IsHide = isHide
 
 		if name != fileInfo.Name.Value {
@@ -110,13 +116,11 @@ func (fu *fileUsecase) Update(id uint64, name string, isHide bool, isDisplayHidd
 				return err
 			}
 
-			if isExists, err := fu.fileInfoService.IsExists(tx, fileInfo); err != nil {
+			if _, err := fu.fileInfoService.ResolveConflict(tx, fileInfo, entity.ConflictFail); err != nil {
 				return err
-			} else if isExists {
-				return fmt.Errorf("%s is already exists", fileInfo.Path.Value)
 			}
 
-			if err := fu.fileBodyRepository.Update(oldPath, path); err != nil {
+			if err := fu.fileBodyRepository.Update(tx, oldPath, path); err != nil {
 				return err
 			}
 		}
@@ -130,24 +134,21 @@ func (fu *fileUsecase) Update(id uint64, name string, isHide bool, isDisplayHidd
 	return fu.convertToFileInfoDTO(fileInfo), nil
 }
 
-func (fu *fileUsecase) Remove(id uint64, isDisplayHiddenObject bool) error {
+func (fu *fileUsecase) Remove(id uint64, principal entity.Principal) error {
 	var fileInfo *entity.FileInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
-		} else {
-			fileInfo, err = fu.fileInfoRepository.FindOneByIDAndIsHide(tx, id, false)
-		}
+		fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
 		if err != nil {
 			return err
 		}
 
-		if err := fu.fileBodyRepository.Remove(fileInfo.Path.Value); err != nil {
+		if err := fu.authorize(tx, principal, fileInfo, entity.PermissionWrite); err != nil {
 			return err
 		}
 
-		return fu.fileInfoRepository.Remove(tx, fileInfo)
+		_, err = fu.trashService.TrashFile(tx, principal.UserID, fileInfo)
+		return err
 	}); err != nil {
 		return err
 	}
@@ -155,39 +156,42 @@ func (fu *fileUsecase) Remove(id uint64, isDisplayHiddenObject bool) error {
 	return nil
 }
 
-func (fu *fileUsecase) Move(id uint64, folderID uint64, isDisplayHiddenObject bool) (*dto.FileInfoDTO, error) {
+func (fu *fileUsecase) Move(id uint64, folderID uint64, conflict entity.ConflictMode, principal entity.Principal) (*dto.FileInfoDTO, error) {
 	var fileInfo *entity.FileInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
-		} else {
-			fileInfo, err = fu.fileInfoRepository.FindOneByIDAndIsHide(tx, id, false)
-		}
+		fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
 		if err != nil {
 			return err
 		}
 
+		if err := fu.authorize(tx, principal, fileInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
 		parentFolder, err := fu.folderInfoRepository.FindOneByID(tx, folderID)
 		if err != nil {
 			return err
 		}
 
-		oldPath := fileInfo.Path.Value
-		path := parentFolder.Path.Value + fileInfo.Name.Value
+		if err := fu.authorizeFolder(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
 
-		if err := fileInfo.Move(oldPath, path); err != nil {
+		oldPath := fileInfo.Path.Value
+		if err := fileInfo.Move(oldPath, parentFolder.Path.Value+fileInfo.Name.Value); err != nil {
 			return err
 		}
 		fileInfo.FolderID = folderID
 
-		if isExists, err := fu.fileInfoService.IsExists(tx, fileInfo); err != nil {
+		if skip, err := fu.resolveConflict(tx, fileInfo, id, conflict, principal); err != nil {
 			return err
-		} else if isExists {
-			return fmt.Errorf("%s is already exists", fileInfo.Path.Value)
+		} else if skip {
+			fileInfo = nil
+			return nil
 		}
 
-		if err := fu.fileBodyRepository.Update(oldPath, path); err != nil {
+		if err := fu.fileBodyRepository.Update(tx, oldPath, fileInfo.Path.Value); err != nil {
 			return err
 		}
 
@@ -197,25 +201,21 @@ func (fu *fileUsecase) Move(id uint64, folderID uint64, isDisplayHiddenObject bo
 		return nil, err
 	}
 
+	if fileInfo == nil {
+		return nil, nil
+	}
 	return fu.convertToFileInfoDTO(fileInfo), nil
 }
 
-func (fu *fileUsecase) Copy(id uint64, folderID uint64, isDisplayHiddenObject bool) (*dto.FileInfoDTO, error) {
+func (fu *fileUsecase) Copy(id uint64, folderID uint64, conflict entity.ConflictMode, principal entity.Principal) (*dto.FileInfoDTO, error) {
 	var fileInfo *entity.FileInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
-		var sourceFileInfo *entity.FileInfo
-		var err error
-		if isDisplayHiddenObject {
-			sourceFileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
-		} else {
-			sourceFileInfo, err = fu.fileInfoRepository.FindOneByIDAndIsHide(tx, id, false)
-		}
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
+		sourceFileInfo, err := fu.fileInfoRepository.FindOneByID(tx, id)
 		if err != nil {
 			return err
 		}
 
-		sourceFileBody, err := fu.fileBodyRepository.Read(sourceFileInfo.Path.Value)
-		if err != nil {
+		if err := fu.authorize(tx, principal, sourceFileInfo, entity.PermissionRead); err != nil {
 			return err
 		}
 
@@ -224,21 +224,24 @@ func (fu *fileUsecase) Copy(id uint64, folderID uint64, isDisplayHiddenObject bo
 			return err
 		}
 
-		path := parentFolder.Path.Value + sourceFileInfo.Name.Value
-		targetFileInfo, err := sourceFileInfo.Copy(path)
+		if err := fu.authorizeFolder(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		targetFileInfo, err := sourceFileInfo.Copy(parentFolder.Path.Value + sourceFileInfo.Name.Value)
 		if err != nil {
 			return err
 		}
 		targetFileInfo.FolderID = folderID
+		targetFileInfo.OwnerID = &principal.UserID
 
-		if isExists, err := fu.fileInfoService.IsExists(tx, targetFileInfo); err != nil {
+		if skip, err := fu.resolveConflict(tx, targetFileInfo, id, conflict, principal); err != nil {
 			return err
-		} else if isExists {
-			return fmt.Errorf("%s is already exists", targetFileInfo.Path.Value)
+		} else if skip {
+			return nil
 		}
 
-		targetFileBody := sourceFileBody.Copy(path)
-		if err := fu.fileBodyRepository.Create(targetFileBody); err != nil {
+		if err := fu.fileBodyRepository.Copy(tx, sourceFileInfo.Path.Value, targetFileInfo.Path.Value); err != nil {
 			return err
 		}
 
@@ -248,29 +251,277 @@ func (fu *fileUsecase) Copy(id uint64, folderID uint64, isDisplayHiddenObject bo
 		return nil, err
 	}
 
+	if fileInfo == nil {
+		return nil, nil
+	}
 	return fu.convertToFileInfoDTO(fileInfo), nil
 }
 
-func (fu *fileUsecase) Read(id uint64, isDisplayHiddenObject bool) (*dto.FileBodyDTO, error) {
+func (fu *fileUsecase) Read(id uint64, principal entity.Principal) (*dto.FileBodyDTO, error) {
+	fileInfo, err := fu.fileInfoRepository.FindOneByID(fu.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fu.authorize(fu.db, principal, fileInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	url, err := fu.fileBodyRepository.PresignedURL(fileInfo.Path.Value, fileInfo.MimeType.Value)
+	if err != nil {
+		return nil, err
+	}
+	if url != "" {
+		return dto.NewFileBodyDTO(fileInfo.Name.Value, fileInfo.MimeType.Value, nil, url, fileInfo.UpdatedAt), nil
+	}
+
+	body, err := fu.fileBodyRepository.Read(fileInfo.Path.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewFileBodyDTO(fileInfo.Name.Value, fileInfo.MimeType.Value, body, "", fileInfo.UpdatedAt), nil
+}
+
+func (fu *fileUsecase) Overwrite(id uint64, body io.Reader, principal entity.Principal) (*dto.FileInfoDTO, error) {
 	var fileInfo *entity.FileInfo
-	var err error
-	if isDisplayHiddenObject {
-		fileInfo, err = fu.fileInfoRepository.FindOneByID(fu.db, id)
-	} else {
-		fileInfo, err = fu.fileInfoRepository.FindOneByIDAndIsHide(fu.db, id, false)
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
+		var err error
+		fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := fu.authorize(tx, principal, fileInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		mimeType, body, err := detectMimeType(body)
+		if err != nil {
+			return err
+		}
+
+		fileInfo, err = fu.overwrite(tx, fileInfo, mimeType, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
+
+	return fu.convertToFileInfoDTO(fileInfo), nil
+}
+
+func (fu *fileUsecase) Extract(id uint64, folderID uint64, conflict entity.ConflictMode, principal entity.Principal) ([]dto.FileInfoDTO, error) {
+	var fileInfos []entity.FileInfo
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
+		archiveFileInfo, err := fu.fileInfoRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := fu.authorize(tx, principal, archiveFileInfo, entity.PermissionRead); err != nil {
+			return err
+		}
+
+		if folderID == 0 {
+			folderID = archiveFileInfo.FolderID
+		}
+		parentFolder, err := fu.folderInfoRepository.FindOneByID(tx, folderID)
+		if err != nil {
+			return err
+		}
+
+		if err := fu.authorizeFolder(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		body, err := fu.fileBodyRepository.Read(archiveFileInfo.Path.Value)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		folders := map[string]*entity.FolderInfo{parentFolder.Path.Value: parentFolder}
+		return walkArchive(body, config.EXTRACT_MAX_ENTRIES, config.EXTRACT_MAX_SIZE, func(entry *archiveEntry) error {
+			names, err := entity.SplitArchivePath(entry.name)
+			if err != nil {
+				return err
+			}
+			if len(names) == 0 {
+				return nil
+			}
+			if entry.isDir {
+				_, err := fu.extractFolder(tx, folders, parentFolder, names, principal)
+				return err
+			}
+
+			folder, err := fu.extractFolder(tx, folders, parentFolder, names[:len(names)-1], principal)
+			if err != nil {
+				return err
+			}
+			fileInfo, err := fu.create(tx, folder, names[len(names)-1], entry.body, false, conflict, principal)
+			if err != nil {
+				return err
+			}
+			if fileInfo != nil {
+				fileInfos = append(fileInfos, *fileInfo)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.FileInfoDTO, len(fileInfos))
+	for i, v := range fileInfos {
+		dtos[i] = *fu.convertToFileInfoDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (fu *fileUsecase) extractFolder(db *gorm.DB, folders map[string]*entity.FolderInfo, parentFolder *entity.FolderInfo, names []string, principal entity.Principal) (*entity.FolderInfo, error) {
+	folderInfo := parentFolder
+	for _, v := range names {
+		path := folderInfo.Path.Value + v + "/"
+		if folder, ok := folders[path]; ok {
+			folderInfo = folder
+			continue
+		}
+
+		folder, err := fu.folderInfoRepository.FindOneByPath(db, path)
+		if err == nil {
+			if err := fu.authorizeFolder(db, principal, folder, entity.PermissionWrite); err != nil {
+				return nil, err
+			}
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			folder, err = entity.NewFolderInfo(&folderInfo.ID, v, path, false)
+			if err != nil {
+				return nil, err
+			}
+			folder.OwnerID = &principal.UserID
+
+			folder, err = fu.folderInfoRepository.Create(db, folder)
+			if err != nil {
+				return nil, err
+			}
+			if err := fu.folderBodyRepository.Create(db, entity.NewFolderBody(folder.Path.Value)); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, err
+		}
+
+		folders[path] = folder
+		folderInfo = folder
+	}
+	return folderInfo, nil
+}
+
+func (fu *fileUsecase) create(db *gorm.DB, parentFolder *entity.FolderInfo, name string, body io.Reader, isHide bool, conflict entity.ConflictMode, principal entity.Principal) (*entity.FileInfo, error) {
+	mimeType, body, err := detectMimeType(body)
 	if err != nil {
 		return nil, err
 	}
 
-	fileBody, err := fu.fileBodyRepository.Read(fileInfo.Path.Value)
+	fileInfo, err := entity.NewFileInfo(parentFolder.ID, name, parentFolder.Path.Value+name, mimeType, isHide)
 	if err != nil {
 		return nil, err
 	}
+	fileInfo.OwnerID = &principal.UserID
 
-	return dto.NewFileBodyDTO(fileInfo.MimeType.Value, fileBody.Body), nil
+	existing, err := fu.fileInfoService.ResolveConflict(db, fileInfo, conflict)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if conflict == entity.ConflictSkip {
+			return nil, nil
+		}
+
+		if err := fu.authorize(db, principal, existing, entity.PermissionWrite); err != nil {
+			return nil, err
+		}
+
+		return fu.overwrite(db, existing, mimeType, body)
+	}
+
+	fileBody := entity.NewFileBody(fileInfo.Path.Value, body)
+	if err := fu.fileBodyRepository.Create(db, fileBody); err != nil {
+		return nil, err
+	}
+	fileInfo.Size = fileBody.Size()
+
+	return fu.fileInfoRepository.Create(db, fileInfo)
+}
+
+func (fu *fileUsecase) overwrite(db *gorm.DB, fileInfo *entity.FileInfo, mimeType string, body io.Reader) (*entity.FileInfo, error) {
+	if _, err := fu.fileVersionService.Archive(db, fileInfo); err != nil {
+		return nil, err
+	}
+
+	fileBody := entity.NewFileBody(fileInfo.Path.Value, body)
+	if err := fu.fileBodyRepository.Create(db, fileBody); err != nil {
+		return nil, err
+	}
+	fileInfo.Size = fileBody.Size()
+
+	if err := fileInfo.SetMimeType(mimeType); err != nil {
+		return nil, err
+	}
+
+	fileInfo, err := fu.fileInfoRepository.Update(db, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return fileInfo, fu.fileVersionService.Prune(db, fileInfo.ID)
+}
+
+func (fu *fileUsecase) resolveConflict(db *gorm.DB, fileInfo *entity.FileInfo, sourceID uint64, conflict entity.ConflictMode, principal entity.Principal) (bool, error) {
+	existing, err := fu.fileInfoService.ResolveConflict(db, fileInfo, conflict)
+	if err != nil || existing == nil {
+		return false, err
+	}
+	if conflict == entity.ConflictSkip {
+		return true, nil
+	}
+	if existing.ID == sourceID {
+		return false, entity.NewFileConflictError(existing)
+	}
+
+	if err := fu.authorize(db, principal, existing, entity.PermissionWrite); err != nil {
+		return false, err
+	}
+
+	_, err = fu.trashService.TrashFile(db, principal.UserID, existing)
+	return false, err
+}
+
+func detectMimeType(body io.Reader) (string, io.Reader, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", nil, err
+	}
+	head = head[:n]
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), body), nil
+}
+
+func (fu *fileUsecase) authorize(db *gorm.DB, principal entity.Principal, file *entity.FileInfo, required entity.Permission) error {
+	permission, err := fu.accessControlService.FilePermission(db, principal, file)
+	if err != nil {
+		return err
+	}
+	return authorize(permission, required)
+}
+
+func (fu *fileUsecase) authorizeFolder(db *gorm.DB, principal entity.Principal, folder *entity.FolderInfo, required entity.Permission) error {
+	permission, err := fu.accessControlService.FolderPermission(db, principal, folder)
+	if err != nil {
+		return err
+	}
+	return authorize(permission, required)
 }
 
 func (fu *fileUsecase) convertToFileInfoDTO(file *entity.FileInfo) *dto.FileInfoDTO {
-	return dto.NewFileInfoDTO(file.ID, file.FolderID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.IsHide, file.CreatedAt, file.UpdatedAt)
+	return dto.NewFileInfoDTO(file.ID, file.FolderID, file.OwnerID, file.Name.Value, file.Path.Value, file.MimeType.Value, file.Size, file.IsHide, file.CreatedAt, file.UpdatedAt)
 }
@@ -1,14 +1,25 @@
 package usecase
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
 	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/pkg/config"
 	"file-server/internal/pkg/types"
 	"file-server/test/database"
 	mock_repository "file-server/test/mock/domain/repository"
 	mock_service "file-server/test/mock/domain/service"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/klauspost/compress/zstd"
+	"gorm.io/gorm"
 )
 
 func TestCreateFile(t *testing.T) {
@@ -24,7 +35,7 @@ func TestCreateFile(t *testing.T) {
 		t.Error(err.Error())
 	}
 
-	fileBody := entity.NewFileBody("name", []byte("file"))
+	fileBody := entity.NewFileBody("name", strings.NewReader("file"))
 
 	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
 	if err != nil {
@@ -35,20 +46,41 @@ func TestCreateFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).Return([]entity.FileInfo{*fileInfo}, nil)
+	fileInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		if file.Size != 4 {
+			t.Error("failed to set file size")
+		}
+		return file, nil
+	})
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
-	fileBodyRepository.EXPECT().Create(gomock.Any()).Return(nil)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileBody) error {
+		_, err := io.Copy(io.Discard, file.Body)
+		return err
+	})
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
-	fileInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
 
-	result, err := fu.Create(fileInfo.FolderID, fileInfo.IsHide, []types.File{{Name: fileInfo.Name.Value, Body: fileBody.Body}})
+	result, err := fu.Create(fileInfo.FolderID, fileInfo.IsHide, []types.File{{Name: fileInfo.Name.Value, Body: fileBody.Body}}, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -58,6 +90,51 @@ func TestCreateFile(t *testing.T) {
 	}
 }
 
+func TestCreateFileWithoutPermission(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{}, folderInfo).Return(entity.PermissionRead, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	if _, err := fu.Create(1, false, []types.File{{Name: "name", Body: strings.NewReader("file")}}, entity.ConflictFail, entity.Principal{}); !errors.Is(err, entity.ErrPermissionDenied) {
+		t.Error("file is created without permission")
+	}
+}
+
 func TestUpdateFile(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
@@ -76,20 +153,33 @@ func TestUpdateFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().FindOneByIDAndIsHide(gomock.Any(), gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
-	fileBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	fileBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
-	fileInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	result, err := fu.Update(fileInfo.ID, "update", true, false)
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Update(fileInfo.ID, "update", true, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -117,19 +207,31 @@ func TestRemoveFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().FindOneByIDAndIsHide(gomock.Any(), fileInfo.ID, fileInfo.IsHide).Return(fileInfo, nil)
-	fileInfoRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
-	fileBodyRepository.EXPECT().Remove(gomock.Any()).Return(nil)
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+	trashService.EXPECT().TrashFile(gomock.Any(), uint64(1), fileInfo).Return(&entity.TrashItem{ID: 1}, nil)
 
-	err = fu.Remove(fileInfo.ID, false)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	err = fu.Remove(fileInfo.ID, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -159,21 +261,35 @@ func TestMoveFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().FindOneByIDAndIsHide(gomock.Any(), gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
-	fileBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	fileBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
-	fileInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
 
-	result, err := fu.Move(fileInfo.ID, 2, false)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Move(fileInfo.ID, 2, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -197,8 +313,6 @@ func TestCopyFile(t *testing.T) {
 	}
 	fileInfo.ID = 1
 
-	fileBody := entity.NewFileBody("name", []byte("file"))
-
 	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
 	if err != nil {
 		t.Error(err.Error())
@@ -209,22 +323,35 @@ func TestCopyFile(t *testing.T) {
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().FindOneByIDAndIsHide(gomock.Any(), gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 	fileInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
-	fileBodyRepository.EXPECT().Read(gomock.Any()).Return(fileBody, nil)
-	fileBodyRepository.EXPECT().Create(gomock.Any()).Return(nil)
+	fileBodyRepository.EXPECT().Copy(gomock.Any(), fileInfo.Path.Value, gomock.Any()).Return(nil)
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
-	fileInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
 
-	result, err := fu.Copy(fileInfo.ID, 2, false)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Copy(fileInfo.ID, 2, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -248,24 +375,41 @@ func TestReadFile(t *testing.T) {
 	}
 	fileInfo.ID = 1
 
-	fileBody := entity.NewFileBody("name", []byte("file"))
+	fileBody, err := os.CreateTemp(t.TempDir(), "file")
+	if err != nil {
+		t.Error(err.Error())
+	}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
-	fileInfoRepository.EXPECT().FindOneByIDAndIsHide(gomock.Any(), gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
 
 	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().PresignedURL(gomock.Any(), gomock.Any()).Return("", nil)
 	fileBodyRepository.EXPECT().Read(gomock.Any()).Return(fileBody, nil)
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
 	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
 
-	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, fileInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
 
-	result, err := fu.Read(fileInfo.ID, false)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Read(fileInfo.ID, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -274,3 +418,548 @@ func TestReadFile(t *testing.T) {
 		t.Error("failed to read file")
 	}
 }
+
+func TestReadFileWithPresignedURL(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().PresignedURL("/path/name", "mime/type").Return("https://s3.example.com/bucket/path/name", nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Read(fileInfo.ID, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.URL != "https://s3.example.com/bucket/path/name" || result.Body != nil {
+		t.Error("failed to presign file")
+	}
+}
+
+func TestOverwriteFile(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	fileVersion := entity.NewFileVersion(fileInfo)
+	fileVersion.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionWrite, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+	fileVersionService.EXPECT().Archive(gomock.Any(), fileInfo).Return(fileVersion, nil)
+	fileVersionService.EXPECT().Prune(gomock.Any(), uint64(1)).Return(nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Overwrite(fileInfo.ID, strings.NewReader("file"), entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.MimeType != "text/plain; charset=utf-8" {
+		t.Error("failed to overwrite file")
+	}
+}
+
+func TestOverwriteFileWithStorageFailure(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	fileVersion := entity.NewFileVersion(fileInfo)
+	fileVersion.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entity.ErrStorageQuotaExceeded)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionWrite, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+	fileVersionService.EXPECT().Archive(gomock.Any(), fileInfo).Return(fileVersion, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	if _, err := fu.Overwrite(fileInfo.ID, strings.NewReader("file"), entity.Principal{UserID: 1}); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Error("failed to return storage error")
+	}
+}
+
+func TestCreateFileWithSkip(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "path", "/path/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictSkip).Return(fileInfo, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Create(1, false, []types.File{{Name: "name", Body: strings.NewReader("file")}}, entity.ConflictSkip, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 0 {
+		t.Error("failed to skip file")
+	}
+}
+
+func TestMoveFileWithOverwrite(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	existingFileInfo, err := entity.NewFileInfo(2, "name", "/dest/name", "mime/type", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	existingFileInfo.ID = 2
+
+	folderInfo, err := entity.NewFolderInfo(nil, "dest", "/dest/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 2
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Update(gomock.Any(), "/path/name", "/dest/name").Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictOverwrite).Return(existingFileInfo, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil).Times(2)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	trashService.EXPECT().TrashFile(gomock.Any(), uint64(1), existingFileInfo).Return(&entity.TrashItem{}, nil)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Move(fileInfo.ID, 2, entity.ConflictOverwrite, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil {
+		t.Error("failed to move file")
+	}
+}
+
+func TestExtractFile(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "archive.zip", "/archive.zip", "application/zip", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	folderInfo, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	archive, err := os.CreateTemp(t.TempDir(), "archive")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	zw := zip.NewWriter(archive)
+	for _, v := range []struct{ name, body string }{{"dir/", ""}, {"dir/a.txt", "hello"}, {"b.txt", "world"}} {
+		f, err := zw.Create(v.name)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if _, err := f.Write([]byte(v.body)); err != nil {
+			t.Error(err.Error())
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		return file, nil
+	}).Times(2)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Read(fileInfo.Path.Value).Return(archive, nil)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileBody) error {
+		_, err := io.Copy(io.Discard, file.Body)
+		return err
+	}).Times(2)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/dir/").Return(nil, gorm.ErrRecordNotFound)
+	folderInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, folder *entity.FolderInfo) (*entity.FolderInfo, error) {
+		if *folder.ParentFolderID != folderInfo.ID {
+			t.Errorf("unexpected parent folder: %d", *folder.ParentFolderID)
+		}
+		folder.ID = 2
+		return folder, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().Create(gomock.Any(), entity.NewFolderBody("/dir/")).Return(nil)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil).Times(2)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	result, err := fu.Extract(fileInfo.ID, 0, entity.ConflictFail, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(result) != 2 || result[0].Path != "/dir/a.txt" || result[0].FolderID != 2 || result[1].Path != "/b.txt" || result[1].Size != 5 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestExtractFileWithUnsafePath(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fileInfo, err := entity.NewFileInfo(1, "archive.tar.gz", "/archive.tar.gz", "application/gzip", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	folderInfo, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	archive, err := os.CreateTemp(t.TempDir(), "archive")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	gw := gzip.NewWriter(archive)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: "../evil.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: 4}); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := tw.Write([]byte("evil")); err != nil {
+		t.Error(err.Error())
+	}
+	if err := tw.Close(); err != nil {
+		t.Error(err.Error())
+	}
+	if err := gw.Close(); err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Read(fileInfo.Path.Value).Return(archive, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	if _, err := fu.Extract(fileInfo.ID, folderInfo.ID, entity.ConflictFail, entity.Principal{UserID: 1}); !errors.Is(err, entity.ErrUnsafeArchivePath) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestExtractFileWithSizeLimit(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	maxSize := config.EXTRACT_MAX_SIZE
+	config.EXTRACT_MAX_SIZE = 4
+	defer func() {
+		config.EXTRACT_MAX_SIZE = maxSize
+	}()
+
+	fileInfo, err := entity.NewFileInfo(1, "archive.tar.zst", "/archive.tar.zst", "application/zstd", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	folderInfo, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	archive, err := os.CreateTemp(t.TempDir(), "archive")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	zw, err := zstd.NewWriter(archive)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	tw := tar.NewWriter(zw)
+	if err := tw.WriteHeader(&tar.Header{Name: "bomb.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: 1024}); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := tw.Write(make([]byte, 1024)); err != nil {
+		t.Error(err.Error())
+	}
+	if err := tw.Close(); err != nil {
+		t.Error(err.Error())
+	}
+	if err := zw.Close(); err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Read(fileInfo.Path.Value).Return(archive, nil)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileBody) error {
+		_, err := io.Copy(io.Discard, file.Body)
+		return fmt.Errorf("%w: %w", entity.ErrStorage, err)
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileInfoService := mock_service.NewMockFileInfoService(ctrl)
+	fileInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileUsecase(db, fileInfoRepository, fileBodyRepository, folderInfoRepository, folderBodyRepository, fileInfoService, accessControlService, trashService, fileVersionService, unitOfWork)
+
+	_, err = fu.Extract(fileInfo.ID, folderInfo.ID, entity.ConflictFail, entity.Principal{UserID: 1})
+	if !errors.Is(err, entity.ErrArchiveTooLarge) || errors.Is(err, entity.ErrStorage) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+
+	"gorm.io/gorm"
+)
+
+type FileVersionUsecase interface {
+	FindAll(uint64, entity.Principal) ([]dto.FileVersionDTO, error)
+	Read(uint64, uint64, entity.Principal) (*dto.FileBodyDTO, error)
+	Restore(uint64, uint64, entity.Principal) (*dto.FileInfoDTO, error)
+}
+
+type fileVersionUsecase struct {
+	db                    *gorm.DB
+	fileVersionRepository repository.FileVersionRepository
+	fileInfoRepository    repository.FileInfoRepository
+	fileBodyRepository    repository.FileBodyRepository
+	fileVersionService    service.FileVersionService
+	accessControlService  service.AccessControlService
+	unitOfWork            service.UnitOfWork
+}
+
+func NewFileVersionUsecase(db *gorm.DB, fileVersionRepository repository.FileVersionRepository, fileInfoRepository repository.FileInfoRepository, fileBodyRepository repository.FileBodyRepository, fileVersionService service.FileVersionService, accessControlService service.AccessControlService, unitOfWork service.UnitOfWork) FileVersionUsecase {
+	return &fileVersionUsecase{
+		db:                    db,
+		fileVersionRepository: fileVersionRepository,
+		fileInfoRepository:    fileInfoRepository,
+		fileBodyRepository:    fileBodyRepository,
+		fileVersionService:    fileVersionService,
+		accessControlService:  accessControlService,
+		unitOfWork:            unitOfWork,
+	}
+}
+
+func (fu *fileVersionUsecase) FindAll(fileID uint64, principal entity.Principal) ([]dto.FileVersionDTO, error) {
+	fileInfo, err := fu.fileInfoRepository.FindOneByID(fu.db, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fu.authorize(fu.db, principal, fileInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	fileVersions, err := fu.fileVersionRepository.FindAllByFileID(fu.db, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.FileVersionDTO, len(fileVersions))
+	for i, v := range fileVersions {
+		dtos[i] = *fu.convertToFileVersionDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (fu *fileVersionUsecase) Read(fileID uint64, id uint64, principal entity.Principal) (*dto.FileBodyDTO, error) {
+	fileInfo, err := fu.fileInfoRepository.FindOneByID(fu.db, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fu.authorize(fu.db, principal, fileInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	fileVersion, err := fu.find(fu.db, fileID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := fu.fileBodyRepository.PresignedURL(fileVersion.Path(), fileVersion.MimeType)
+	if err != nil {
+		return nil, err
+	}
+	if url != "" {
+		return dto.NewFileBodyDTO(fileInfo.Name.Value, fileVersion.MimeType, nil, url, fileVersion.CreatedAt), nil
+	}
+
+	body, err := fu.fileBodyRepository.Read(fileVersion.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewFileBodyDTO(fileInfo.Name.Value, fileVersion.MimeType, body, "", fileVersion.CreatedAt), nil
+}
+
+func (fu *fileVersionUsecase) Restore(fileID uint64, id uint64, principal entity.Principal) (*dto.FileInfoDTO, error) {
+	var fileInfo *entity.FileInfo
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
+		var err error
+		fileInfo, err = fu.fileInfoRepository.FindOneByID(tx, fileID)
+		if err != nil {
+			return err
+		}
+
+		if err := fu.authorize(tx, principal, fileInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		fileVersion, err := fu.find(tx, fileID, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fu.fileVersionService.Archive(tx, fileInfo); err != nil {
+			return err
+		}
+
+		if err := fu.fileBodyRepository.Copy(tx, fileVersion.Path(), fileInfo.Path.Value); err != nil {
+			return err
+		}
+
+		if err := fileInfo.SetMimeType(fileVersion.MimeType); err != nil {
+			return err
+		}
+		fileInfo.Size = fileVersion.Size
+
+		fileInfo, err = fu.fileInfoRepository.Update(tx, fileInfo)
+		if err != nil {
+			return err
+		}
+
+		return fu.fileVersionService.Prune(tx, fileInfo.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return dto.NewFileInfoDTO(fileInfo.ID, fileInfo.FolderID, fileInfo.OwnerID, fileInfo.Name.Value, fileInfo.Path.Value, fileInfo.MimeType.Value, fileInfo.Size, fileInfo.IsHide, fileInfo.CreatedAt, fileInfo.UpdatedAt), nil
+}
+
+func (fu *fileVersionUsecase) find(db *gorm.DB, fileID uint64, id uint64) (*entity.FileVersion, error) {
+	fileVersion, err := fu.fileVersionRepository.FindOneByID(db, id)
+	if err != nil {
+		return nil, err
+	}
+	if fileVersion.FileID != fileID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return fileVersion, nil
+}
+
+func (fu *fileVersionUsecase) authorize(db *gorm.DB, principal entity.Principal, file *entity.FileInfo, required entity.Permission) error {
+	permission, err := fu.accessControlService.FilePermission(db, principal, file)
+	if err != nil {
+		return err
+	}
+	return authorize(permission, required)
+}
+
+func (fu *fileVersionUsecase) convertToFileVersionDTO(fileVersion *entity.FileVersion) *dto.FileVersionDTO {
+	return dto.NewFileVersionDTO(fileVersion.ID, fileVersion.FileID, fileVersion.MimeType, fileVersion.CreatedAt)
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRestoreFileVersion(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	fileVersion := &entity.FileVersion{ID: 1, FileID: 1, MimeType: "image/png"}
+	archived := &entity.FileVersion{ID: 2, FileID: 1, MimeType: "text/plain"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(fileVersion, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(fileInfo, nil)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Copy(gomock.Any(), "/:versions/1/1", "/path/name").Return(nil)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+	fileVersionService.EXPECT().Archive(gomock.Any(), fileInfo).Return(archived, nil)
+	fileVersionService.EXPECT().Prune(gomock.Any(), uint64(1)).Return(nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionWrite, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileVersionUsecase(db, fileVersionRepository, fileInfoRepository, fileBodyRepository, fileVersionService, accessControlService, unitOfWork)
+
+	result, err := fu.Restore(1, 1, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.MimeType != "image/png" {
+		t.Error("failed to restore file version")
+	}
+}
+
+func TestRestoreFileVersionOfOtherFile(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/path/name", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fileVersionRepository := mock_repository.NewMockFileVersionRepository(ctrl)
+	fileVersionRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(&entity.FileVersion{ID: 1, FileID: 2, MimeType: "image/png"}, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(fileInfo, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionWrite, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFileVersionUsecase(db, fileVersionRepository, fileInfoRepository, fileBodyRepository, fileVersionService, accessControlService, unitOfWork)
+
+	if _, err := fu.Restore(1, 1, entity.Principal{UserID: 1}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Error("failed to reject version of other file")
+	}
+}
@@ -1,65 +1,91 @@
 package usecase
 
 import (
-	"archive/zip"
-	"bytes"
+	"context"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/internal/app/api/domain/repository"
 	"file-server/internal/app/api/domain/service"
 	"file-server/internal/app/api/usecase/dto"
-	"fmt"
-	"io/fs"
+	"io"
 	"strings"
-	"time"
 
 	"gorm.io/gorm"
 )
 
 type FolderUsecase interface {
-	Create(uint64, string, bool) (*dto.FolderInfoDTO, error)
-	Update(uint64, string, bool, bool) (*dto.FolderInfoDTO, error)
-	Remove(uint64, bool) error
-	Move(uint64, uint64, bool) (*dto.FolderInfoDTO, error)
-	Copy(uint64, uint64, bool) (*dto.FolderInfoDTO, error)
-	FindOne(string, bool) (*dto.FolderInfoDTO, error)
-	Read(uint64, bool) (*dto.FolderBodyDTO, error)
+	Create(uint64, string, bool, entity.ConflictMode, entity.Principal) (*dto.FolderInfoDTO, error)
+	Update(uint64, string, bool, entity.Principal) (*dto.FolderInfoDTO, error)
+	Remove(uint64, entity.Principal) error
+	Move(uint64, uint64, entity.ConflictMode, entity.Principal) (*dto.FolderInfoDTO, error)
+	Copy(uint64, uint64, entity.ConflictMode, entity.Principal) (*dto.FolderInfoDTO, error)
+	FindOne(string, entity.Principal) (*dto.FolderInfoDTO, error)
+	List(uint64, *entity.ListQuery, entity.Principal) (*dto.FolderListDTO, error)
+	Count(uint64, *entity.ListFilter, entity.Principal) (*dto.FolderCountDTO, error)
+	Search(uint64, *entity.ListQuery, entity.Principal) (*dto.FolderListDTO, error)
+	Read(context.Context, uint64, entity.Principal) (*dto.FolderBodyDTO, error)
+	Archive(context.Context, []uint64, []uint64, entity.ArchiveFormat, entity.Principal) (*dto.FolderBodyDTO, error)
 }
 
 type folderUsecase struct {
 	db                   *gorm.DB
 	folderInfoRepository repository.FolderInfoRepository
+	fileInfoRepository   repository.FileInfoRepository
 	folderBodyRepository repository.FolderBodyRepository
+	fileBodyRepository   repository.FileBodyRepository
 	folderInfoService    service.FolderInfoService
+	accessControlService service.AccessControlService
+	trashService         service.TrashService
+	unitOfWork           service.UnitOfWork
 }
 
-func NewFolderUsecase(db *gorm.DB, folderInfoRepository repository.FolderInfoRepository, folderBodyRepository repository.FolderBodyRepository, folderInfoService service.FolderInfoService) FolderUsecase {
+func NewFolderUsecase(db *gorm.DB, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository, folderInfoService service.FolderInfoService, accessControlService service.AccessControlService, trashService service.TrashService, unitOfWork service.UnitOfWork) FolderUsecase {
 	return &folderUsecase{
 		db:                   db,
 		folderInfoRepository: folderInfoRepository,
+		fileInfoRepository:   fileInfoRepository,
 		folderBodyRepository: folderBodyRepository,
+		fileBodyRepository:   fileBodyRepository,
 		folderInfoService:    folderInfoService,
+		accessControlService: accessControlService,
+		trashService:         trashService,
+		unitOfWork:           unitOfWork,
 	}
 }
 
-func (fu *folderUsecase) Create(parentFolderID uint64, name string, isHide bool) (*dto.FolderInfoDTO, error) {
+func (fu *folderUsecase) Create(parentFolderID uint64, name string, isHide bool, conflict entity.ConflictMode, principal entity.Principal) (*dto.FolderInfoDTO, error) {
 	var folderInfo *entity.FolderInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		parentFolder, err := fu.folderInfoRepository.FindOneByID(tx, parentFolderID)
 		if err != nil {
 			return err
 		}
 
-		path := parentFolder.Path.Value + name + "/"
+		if err := fu.authorize(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
 
-		folderInfo, err = entity.NewFolderInfo(&parentFolderID, name, path, isHide)
+		folderInfo, err = entity.NewFolderInfo(&parentFolderID, name, parentFolder.Path.Value+name+"/", isHide)
 		if err != nil {
 			return err
 		}
+		folderInfo.OwnerID = &principal.UserID
 
-		if isExists, err := fu.folderInfoService.IsExists(tx, folderInfo); err != nil {
+		existing, err := fu.folderInfoService.ResolveConflict(tx, folderInfo, conflict)
+		if err != nil {
 			return err
-		} else if isExists {
-			return fmt.Errorf("%s is already exists", folderInfo.Path.Value)
+		}
+		if existing != nil {
+			switch conflict {
+			case entity.ConflictSkip:
+				folderInfo = nil
+				return nil
+			case entity.ConflictMerge:
+				folderInfo = existing
+				return nil
+			}
+			if err := fu.trash(tx, existing, principal); err != nil {
+				return err
+			}
 		}
 
 		folderInfo, err = fu.folderInfoRepository.Create(tx, folderInfo)
@@ -67,31 +93,34 @@ func (fu *folderUsecase) Create(parentFolderID uint64, name string, isHide bool)
 			return err
 		}
 
-		folderBody := entity.NewFolderBody(path)
+		folderBody := entity.NewFolderBody(folderInfo.Path.Value)
 
-		return fu.folderBodyRepository.Create(folderBody)
+		return fu.folderBodyRepository.Create(tx, folderBody)
 	}); err != nil {
 		return nil, err
 	}
 
+	if folderInfo == nil {
+		return nil, nil
+	}
 	return fu.convertToFolderInfoDTO(folderInfo), nil
 }
 
-func (fu *folderUsecase) Update(id uint64, name string, isHide bool, isDisplayHiddenObject bool) (*dto.FolderInfoDTO, error) {
+func (fu *folderUsecase) Update(id uint64, name string, isHide bool, principal entity.Principal) (*dto.FolderInfoDTO, error) {
 	var folderInfo *entity.FolderInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
-		} else {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDAndIsHideWithLower(tx, id, false)
-		}
+		folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
 		if err != nil {
 			return err
 		}
 
+		if err := fu.authorize(tx, principal, folderInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
 		if folderInfo.IsRoot() {
-			return fmt.Errorf("root directory is not updatable")
+			return entity.NewValidationError("root_folder_not_updatable", "root directory is not updatable")
 		}
 
 		folderInfo.IsHide = isHide
@@ -108,13 +137,11 @@ func (fu *folderUsecase) Update(id uint64, name string, isHide bool, isDisplayHi
 				return err
 			}
 
-			if isExists, err := fu.folderInfoService.IsExists(tx, folderInfo); err != nil {
+			if _, err := fu.folderInfoService.ResolveConflict(tx, folderInfo, entity.ConflictFail); err != nil {
 				return err
-			} else if isExists {
-				return fmt.Errorf("%s is already exists", folderInfo.Path.Value)
 			}
 
-			if err := fu.folderBodyRepository.Update(oldPath, path); err != nil {
+			if err := fu.folderBodyRepository.Update(tx, oldPath, path); err != nil {
 				return err
 			}
 		}
@@ -128,28 +155,25 @@ func (fu *folderUsecase) Update(id uint64, name string, isHide bool, isDisplayHi
 	return fu.convertToFolderInfoDTO(folderInfo), nil
 }
 
-func (fu *folderUsecase) Remove(id uint64, isDisplayHiddenObject bool) error {
+func (fu *folderUsecase) Remove(id uint64, principal entity.Principal) error {
 	var folderInfo *entity.FolderInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
-		} else {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDAndIsHideWithLower(tx, id, false)
-		}
+		folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
 		if err != nil {
 			return err
 		}
 
-		if folderInfo.IsRoot() {
-			return fmt.Errorf("root directory is not removable")
+		if err := fu.authorize(tx, principal, folderInfo, entity.PermissionWrite); err != nil {
+			return err
 		}
 
-		if err := fu.folderBodyRepository.Remove(folderInfo.Path.Value); err != nil {
-			return err
+		if folderInfo.IsRoot() {
+			return entity.NewValidationError("root_folder_not_removable", "root directory is not removable")
 		}
 
-		return fu.folderInfoRepository.Remove(tx, folderInfo)
+		_, err = fu.trashService.TrashFolder(tx, principal.UserID, folderInfo)
+		return err
 	}); err != nil {
 		return err
 	}
@@ -157,21 +181,21 @@ func (fu *folderUsecase) Remove(id uint64, isDisplayHiddenObject bool) error {
 	return nil
 }
 
-func (fu *folderUsecase) Move(id uint64, parentFolderID uint64, isDisplayHiddenObject bool) (*dto.FolderInfoDTO, error) {
+func (fu *folderUsecase) Move(id uint64, parentFolderID uint64, conflict entity.ConflictMode, principal entity.Principal) (*dto.FolderInfoDTO, error) {
 	var folderInfo *entity.FolderInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
 		var err error
-		if isDisplayHiddenObject {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
-		} else {
-			folderInfo, err = fu.folderInfoRepository.FindOneByIDAndIsHideWithLower(tx, id, false)
-		}
+		folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
 		if err != nil {
 			return err
 		}
 
+		if err := fu.authorize(tx, principal, folderInfo, entity.PermissionWrite); err != nil {
+			return err
+		}
+
 		if folderInfo.IsRoot() {
-			return fmt.Errorf("root directory is not updatable")
+			return entity.NewValidationError("root_folder_not_updatable", "root directory is not updatable")
 		}
 
 		parentFolder, err := fu.folderInfoRepository.FindOneByID(tx, parentFolderID)
@@ -179,24 +203,53 @@ func (fu *folderUsecase) Move(id uint64, parentFolderID uint64, isDisplayHiddenO
 			return err
 		}
 
+		if err := fu.authorize(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
+
 		oldPath := folderInfo.Path.Value
 		if strings.Contains(parentFolder.Path.Value, oldPath) {
-			return fmt.Errorf("cannot move to lower directory")
+			return entity.NewValidationError("invalid_destination", "cannot move to lower directory")
 		}
-		path := parentFolder.Path.Value + folderInfo.Name.Value + "/"
 
-		if err := folderInfo.Move(oldPath, path); err != nil {
+		if err := folderInfo.Move(oldPath, parentFolder.Path.Value+folderInfo.Name.Value+"/"); err != nil {
 			return err
 		}
 		folderInfo.ParentFolderID = &parentFolderID
 
-		if isExists, err := fu.folderInfoService.IsExists(tx, folderInfo); err != nil {
+		existing, err := fu.resolveConflict(tx, folderInfo, oldPath, conflict, principal)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if conflict == entity.ConflictSkip {
+				folderInfo = nil
+				return nil
+			}
+
+			source, err := fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
+			if err != nil {
+				return err
+			}
+			if err := fu.mergeConflict(source, existing); err != nil {
+				return err
+			}
+			if err := fu.mergeMove(tx, source, existing); err != nil {
+				return err
+			}
+			source, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
+			if err != nil {
+				return err
+			}
+			if _, err := fu.trashService.TrashFolder(tx, principal.UserID, source); err != nil {
+				return err
+			}
+
+			folderInfo, err = fu.folderInfoRepository.FindOneByID(tx, existing.ID)
 			return err
-		} else if isExists {
-			return fmt.Errorf("%s is already exists", folderInfo.Path.Value)
 		}
 
-		if err := fu.folderBodyRepository.Update(oldPath, path); err != nil {
+		if err := fu.folderBodyRepository.Update(tx, oldPath, folderInfo.Path.Value); err != nil {
 			return err
 		}
 
@@ -206,25 +259,24 @@ func (fu *folderUsecase) Move(id uint64, parentFolderID uint64, isDisplayHiddenO
 		return nil, err
 	}
 
+	if folderInfo == nil {
+		return nil, nil
+	}
 	return fu.convertToFolderInfoDTO(folderInfo), nil
 }
 
-func (fu *folderUsecase) Copy(id uint64, parentFolderID uint64, isDisplayHiddenObject bool) (*dto.FolderInfoDTO, error) {
+func (fu *folderUsecase) Copy(id uint64, parentFolderID uint64, conflict entity.ConflictMode, principal entity.Principal) (*dto.FolderInfoDTO, error) {
 	var folderInfo *entity.FolderInfo
-	if err := fu.db.Transaction(func(tx *gorm.DB) error {
-		var sourceFolderInfo *entity.FolderInfo
-		var err error
-		if isDisplayHiddenObject {
-			sourceFolderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
-		} else {
-			sourceFolderInfo, err = fu.folderInfoRepository.FindOneByIDAndIsHideWithLower(tx, id, false)
-		}
+	if err := fu.unitOfWork.Transaction(fu.db, func(tx *gorm.DB) error {
+		sourceFolderInfo, err := fu.folderInfoRepository.FindOneByIDWithLower(tx, id)
 		if err != nil {
 			return err
 		}
 
-		sourceFolderBody, err := fu.folderBodyRepository.Read(sourceFolderInfo.Path.Value)
-		if err != nil {
+		if err := fu.authorize(tx, principal, sourceFolderInfo, entity.PermissionRead); err != nil {
+			return err
+		}
+		if err := fu.accessControlService.Filter(tx, principal, sourceFolderInfo); err != nil {
 			return err
 		}
 
@@ -233,21 +285,38 @@ func (fu *folderUsecase) Copy(id uint64, parentFolderID uint64, isDisplayHiddenO
 			return err
 		}
 
-		path := parentFolder.Path.Value + sourceFolderInfo.Name.Value + "/"
-		targetFolderInfo, err := sourceFolderInfo.Copy(path)
+		if err := fu.authorize(tx, principal, parentFolder, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		targetFolderInfo, err := sourceFolderInfo.Copy(parentFolder.Path.Value + sourceFolderInfo.Name.Value + "/")
 		if err != nil {
 			return err
 		}
 		targetFolderInfo.ParentFolderID = &parentFolderID
+		targetFolderInfo.SetOwner(&principal.UserID)
 
-		if isExists, err := fu.folderInfoService.IsExists(tx, targetFolderInfo); err != nil {
+		existing, err := fu.resolveConflict(tx, targetFolderInfo, sourceFolderInfo.Path.Value, conflict, principal)
+		if err != nil {
 			return err
-		} else if isExists {
-			return fmt.Errorf("%s is already exists", targetFolderInfo.Path.Value)
 		}
+		if existing != nil {
+			if conflict == entity.ConflictSkip {
+				return nil
+			}
+
+			if err := fu.mergeConflict(sourceFolderInfo, existing); err != nil {
+				return err
+			}
+			if err := fu.mergeCopy(tx, sourceFolderInfo, existing, principal); err != nil {
+				return err
+			}
 
-		targetFolderBody := sourceFolderBody.Copy(path)
-		if err := fu.folderBodyRepository.Create(targetFolderBody); err != nil {
+			folderInfo, err = fu.folderInfoRepository.FindOneByID(tx, existing.ID)
+			return err
+		}
+
+		if err := fu.copyBody(tx, sourceFolderInfo, targetFolderInfo); err != nil {
 			return err
 		}
 
@@ -257,78 +326,539 @@ func (fu *folderUsecase) Copy(id uint64, parentFolderID uint64, isDisplayHiddenO
 		return nil, err
 	}
 
+	if folderInfo == nil {
+		return nil, nil
+	}
 	return fu.convertToFolderInfoDTO(folderInfo), nil
 }
 
-func (fu *folderUsecase) FindOne(path string, isDisplayHiddenObject bool) (*dto.FolderInfoDTO, error) {
-	var folderInfo *entity.FolderInfo
-	var err error
-	if isDisplayHiddenObject {
-		folderInfo, err = fu.folderInfoRepository.FindOneByPathWithChildren(fu.db, path)
-	} else {
-		folderInfo, err = fu.folderInfoRepository.FindOneByPathAndIsHideWithChildren(fu.db, path, false)
+func (fu *folderUsecase) resolveConflict(db *gorm.DB, folderInfo *entity.FolderInfo, sourcePath string, conflict entity.ConflictMode, principal entity.Principal) (*entity.FolderInfo, error) {
+	existing, err := fu.folderInfoService.ResolveConflict(db, folderInfo, conflict)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+	if conflict == entity.ConflictSkip {
+		return existing, nil
+	}
+	if strings.HasPrefix(sourcePath, existing.Path.Value) {
+		return nil, entity.NewFolderConflictError(existing)
+	}
+
+	if conflict == entity.ConflictOverwrite {
+		return nil, fu.trash(db, existing, principal)
+	}
+
+	existing, err = fu.folderInfoRepository.FindOneByIDWithLower(db, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := fu.authorize(db, principal, existing, entity.PermissionWrite); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (fu *folderUsecase) trash(db *gorm.DB, folderInfo *entity.FolderInfo, principal entity.Principal) error {
+	folderInfo, err := fu.folderInfoRepository.FindOneByIDWithLower(db, folderInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := fu.authorize(db, principal, folderInfo, entity.PermissionWrite); err != nil {
+		return err
+	}
+
+	_, err = fu.trashService.TrashFolder(db, principal.UserID, folderInfo)
+	return err
+}
+
+func (fu *folderUsecase) mergeConflict(source *entity.FolderInfo, target *entity.FolderInfo) error {
+	for _, v := range source.Folders {
+		if folder := fu.findFolder(target, v.Name.Value); folder != nil {
+			if err := fu.mergeConflict(&v, folder); err != nil {
+				return err
+			}
+		}
+	}
+	for _, v := range source.Files {
+		if file := fu.findFile(target, v.Name.Value); file != nil {
+			return entity.NewFileConflictError(file)
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) mergeMove(db *gorm.DB, source *entity.FolderInfo, target *entity.FolderInfo) error {
+	for _, v := range source.Folders {
+		if folder := fu.findFolder(target, v.Name.Value); folder != nil {
+			if err := fu.mergeMove(db, &v, folder); err != nil {
+				return err
+			}
+			continue
+		}
+
+		oldPath := v.Path.Value
+		path := target.Path.Value + v.Name.Value + "/"
+		if err := fu.folderBodyRepository.Update(db, oldPath, path); err != nil {
+			return err
+		}
+		if err := v.Move(oldPath, path); err != nil {
+			return err
+		}
+		v.ParentFolderID = &target.ID
+		if _, err := fu.folderInfoRepository.Update(db, &v); err != nil {
+			return err
+		}
+	}
+	for _, v := range source.Files {
+		oldPath := v.Path.Value
+		path := target.Path.Value + v.Name.Value
+		if err := fu.fileBodyRepository.Update(db, oldPath, path); err != nil {
+			return err
+		}
+		if err := v.Move(oldPath, path); err != nil {
+			return err
+		}
+		v.FolderID = target.ID
+		if _, err := fu.fileInfoRepository.Update(db, &v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) mergeCopy(db *gorm.DB, source *entity.FolderInfo, target *entity.FolderInfo, principal entity.Principal) error {
+	for _, v := range source.Folders {
+		if folder := fu.findFolder(target, v.Name.Value); folder != nil {
+			if err := fu.mergeCopy(db, &v, folder, principal); err != nil {
+				return err
+			}
+			continue
+		}
+
+		folder, err := v.Copy(target.Path.Value + v.Name.Value + "/")
+		if err != nil {
+			return err
+		}
+		folder.ParentFolderID = &target.ID
+		folder.SetOwner(&principal.UserID)
+		if err := fu.copyBody(db, &v, folder); err != nil {
+			return err
+		}
+		if _, err := fu.folderInfoRepository.Create(db, folder); err != nil {
+			return err
+		}
+	}
+	for _, v := range source.Files {
+		file, err := v.Copy(target.Path.Value + v.Name.Value)
+		if err != nil {
+			return err
+		}
+		file.FolderID = target.ID
+		file.OwnerID = &principal.UserID
+		if err := fu.fileBodyRepository.Copy(db, v.Path.Value, file.Path.Value); err != nil {
+			return err
+		}
+		if _, err := fu.fileInfoRepository.Create(db, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) findFolder(parent *entity.FolderInfo, name string) *entity.FolderInfo {
+	for i, v := range parent.Folders {
+		if v.Name.Value == name {
+			return &parent.Folders[i]
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) findFile(parent *entity.FolderInfo, name string) *entity.FileInfo {
+	for i, v := range parent.Files {
+		if v.Name.Value == name {
+			return &parent.Files[i]
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) copyBody(db *gorm.DB, source *entity.FolderInfo, target *entity.FolderInfo) error {
+	if err := fu.folderBodyRepository.Create(db, entity.NewFolderBody(target.Path.Value)); err != nil {
+		return err
+	}
+	for i, v := range source.Folders {
+		if err := fu.copyBody(db, &v, &target.Folders[i]); err != nil {
+			return err
+		}
+	}
+	for i, v := range source.Files {
+		if err := fu.fileBodyRepository.Copy(db, v.Path.Value, target.Files[i].Path.Value); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+func (fu *folderUsecase) FindOne(path string, principal entity.Principal) (*dto.FolderInfoDTO, error) {
+	folderInfo, err := fu.folderInfoRepository.FindOneByPathWithChildren(fu.db, path)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := fu.authorize(fu.db, principal, folderInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+	if err := fu.accessControlService.Filter(fu.db, principal, folderInfo); err != nil {
+		return nil, err
+	}
+
 	return fu.convertToFolderInfoDTO(folderInfo), nil
 }
 
-func (fu *folderUsecase) Read(id uint64, isDisplayHiddenObject bool) (*dto.FolderBodyDTO, error) {
-	var folderInfo *entity.FolderInfo
-	var err error
-	if isDisplayHiddenObject {
-		folderInfo, err = fu.folderInfoRepository.FindOneByIDWithLower(fu.db, id)
-	} else {
-		folderInfo, err = fu.folderInfoRepository.FindOneByIDAndIsHideWithLower(fu.db, id, false)
+func (fu *folderUsecase) List(id uint64, query *entity.ListQuery, principal entity.Principal) (*dto.FolderListDTO, error) {
+	if err := fu.authorizeList(id, &query.Filter, principal); err != nil {
+		return nil, err
+	}
+
+	var folders []entity.FolderInfo
+	var next *entity.ListCursor
+	remaining := query.Limit
+	if query.Includes(entity.ListKindFolder) {
+		var err error
+		folders, err = fu.folderInfoRepository.FindAllByParentFolderID(fu.db, id, query.With(entity.ListKindFolder, remaining+1))
+		if err != nil {
+			return nil, err
+		}
+		if remaining < len(folders) {
+			folders = folders[:remaining]
+			next = entity.NewFolderListCursor(&folders[remaining-1], query.Sort)
+		}
+		remaining -= len(folders)
+	}
+
+	var files []entity.FileInfo
+	if next == nil {
+		var err error
+		files, err = fu.fileInfoRepository.FindAllByFolderID(fu.db, id, query.With(entity.ListKindFile, remaining+1))
+		if err != nil {
+			return nil, err
+		}
+		if remaining < len(files) {
+			files = files[:remaining]
+			if remaining == 0 {
+				next = &entity.ListCursor{Kind: entity.ListKindFile}
+			} else {
+				next = entity.NewFileListCursor(&files[remaining-1], query.Sort)
+			}
+		}
+	}
+
+	return fu.convertToFolderListDTO(folders, files, next), nil
+}
+
+func (fu *folderUsecase) Count(id uint64, filter *entity.ListFilter, principal entity.Principal) (*dto.FolderCountDTO, error) {
+	if err := fu.authorizeList(id, filter, principal); err != nil {
+		return nil, err
+	}
+
+	var folders int64
+	if filter.Includes(entity.ListKindFolder) {
+		var err error
+		folders, err = fu.folderInfoRepository.CountByParentFolderID(fu.db, id, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var files int64
+	if filter.Includes(entity.ListKindFile) {
+		var err error
+		files, err = fu.fileInfoRepository.CountByFolderID(fu.db, id, filter)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return dto.NewFolderCountDTO(folders, files), nil
+}
+
+func (fu *folderUsecase) Search(id uint64, query *entity.ListQuery, principal entity.Principal) (*dto.FolderListDTO, error) {
+	folderInfo, err := fu.folderInfoRepository.FindOneByID(fu.db, id)
 	if err != nil {
 		return nil, err
 	}
 
-	folderBody, err := fu.folderBodyRepository.Read(folderInfo.Path.Value)
+	if err := fu.authorize(fu.db, principal, folderInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	query.Filter.Path = folderInfo.Path.Value
+	query.Filter.Visibility = entity.Visibility{IncludeHidden: true}
+
+	var folders []entity.FolderInfo
+	var next *entity.ListCursor
+	remaining := query.Limit
+	if query.Includes(entity.ListKindFolder) {
+		folders, next, err = fu.searchFolders(folderInfo, query, remaining, principal)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= len(folders)
+	}
+
+	var files []entity.FileInfo
+	if next == nil && query.Includes(entity.ListKindFile) {
+		files, next, err = fu.searchFiles(folderInfo, query, remaining, principal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fu.convertToFolderListDTO(folders, files, next), nil
+}
+
+func (fu *folderUsecase) Read(ctx context.Context, id uint64, principal entity.Principal) (*dto.FolderBodyDTO, error) {
+	folderInfo, err := fu.folderInfoRepository.FindOneByIDWithLower(fu.db, id)
 	if err != nil {
 		return nil, err
 	}
 
-	buf := new(bytes.Buffer)
-	w := zip.NewWriter(buf)
+	if err := fu.authorize(fu.db, principal, folderInfo, entity.PermissionRead); err != nil {
+		return nil, err
+	}
+	if err := fu.accessControlService.Filter(fu.db, principal, folderInfo); err != nil {
+		return nil, err
+	}
 
-	var compress func(*zip.Writer, *entity.FolderBody, string) error
-	compress = func(w *zip.Writer, folderBody *entity.FolderBody, innerPath string) error {
-		for _, v := range folderBody.Folders {
-			name := v.Path[strings.LastIndex(v.Path[:len(v.Path)-1], "/")+1:]
-			if err := compress(w, &v, innerPath+name+"/"); err != nil {
-				return err
+	r, w := io.Pipe()
+	go func() {
+		aw := newArchiveWriter(w, entity.ArchiveZip)
+		if err := fu.compress(ctx, aw, folderInfo, folderInfo.Name.Value+"/"); err != nil {
+			w.CloseWithError(err)
+			return
+		}
+		w.CloseWithError(aw.Close())
+	}()
+
+	return dto.NewFolderBodyDTO(folderInfo.Name.Value+".zip", entity.ArchiveZip.MimeType(), r), nil
+}
+
+func (fu *folderUsecase) Archive(ctx context.Context, folderIDs []uint64, fileIDs []uint64, format entity.ArchiveFormat, principal entity.Principal) (*dto.FolderBodyDTO, error) {
+	if len(folderIDs) == 0 && len(fileIDs) == 0 {
+		return nil, entity.NewValidationError("empty_archive", "no folders or files are selected")
+	}
+
+	names := make(map[string]bool)
+	seen := make(map[uint64]bool)
+	folderInfos := make([]entity.FolderInfo, 0, len(folderIDs))
+	for _, v := range folderIDs {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+
+		folderInfo, err := fu.folderInfoRepository.FindOneByIDWithLower(fu.db, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := fu.authorize(fu.db, principal, folderInfo, entity.PermissionRead); err != nil {
+			return nil, err
+		}
+		if err := fu.accessControlService.Filter(fu.db, principal, folderInfo); err != nil {
+			return nil, err
+		}
+
+		if names[folderInfo.Name.Value] {
+			return nil, entity.NewFolderConflictError(folderInfo)
+		}
+		names[folderInfo.Name.Value] = true
+		folderInfos = append(folderInfos, *folderInfo)
+	}
+
+	seen = make(map[uint64]bool)
+	fileInfos := make([]entity.FileInfo, 0, len(fileIDs))
+	for _, v := range fileIDs {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+
+		fileInfo, err := fu.fileInfoRepository.FindOneByID(fu.db, v)
+		if err != nil {
+			return nil, err
+		}
+
+		permission, err := fu.accessControlService.FilePermission(fu.db, principal, fileInfo)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorize(permission, entity.PermissionRead); err != nil {
+			return nil, err
+		}
+
+		if names[fileInfo.Name.Value] {
+			return nil, entity.NewFileConflictError(fileInfo)
+		}
+		names[fileInfo.Name.Value] = true
+		fileInfos = append(fileInfos, *fileInfo)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		aw := newArchiveWriter(w, format)
+		for _, v := range folderInfos {
+			if err := fu.compress(ctx, aw, &v, v.Name.Value+"/"); err != nil {
+				w.CloseWithError(err)
+				return
 			}
 		}
-		for _, v := range folderBody.Files {
-			header := &zip.FileHeader{
-				Name:     innerPath + v.Path[strings.LastIndex(v.Path, "/")+1:],
-				Method:   zip.Deflate,
-				Modified: time.Now(),
+		for _, v := range fileInfos {
+			if err := ctx.Err(); err != nil {
+				w.CloseWithError(err)
+				return
 			}
-			header.SetMode(fs.ModePerm)
-			f, err := w.CreateHeader(header)
-			if err != nil {
-				return err
+			if err := fu.compressFile(aw, &v, v.Name.Value); err != nil {
+				w.CloseWithError(err)
+				return
 			}
-			if _, err := f.Write(v.Body); err != nil {
-				return err
+		}
+		w.CloseWithError(aw.Close())
+	}()
+
+	return dto.NewFolderBodyDTO("archive."+string(format), format.MimeType(), r), nil
+}
+
+func (fu *folderUsecase) compress(ctx context.Context, w archiveWriter, folderInfo *entity.FolderInfo, innerPath string) error {
+	if err := w.CreateFolder(innerPath, folderInfo.UpdatedAt); err != nil {
+		return err
+	}
+
+	for _, v := range folderInfo.Folders {
+		if err := fu.compress(ctx, w, &v, innerPath+v.Name.Value+"/"); err != nil {
+			return err
+		}
+	}
+	for _, v := range folderInfo.Files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fu.compressFile(w, &v, innerPath+v.Name.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fu *folderUsecase) compressFile(w archiveWriter, fileInfo *entity.FileInfo, name string) error {
+	body, err := fu.fileBodyRepository.Read(fileInfo.Path.Value)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return w.CreateFile(name, fileInfo.UpdatedAt, body)
+}
+
+func (fu *folderUsecase) searchFolders(root *entity.FolderInfo, query *entity.ListQuery, limit int, principal entity.Principal) ([]entity.FolderInfo, *entity.ListCursor, error) {
+	batch := query.With(entity.ListKindFolder, query.Limit+1)
+	var folders []entity.FolderInfo
+	for {
+		candidates, err := fu.folderInfoRepository.Search(fu.db, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		readable, _, err := fu.accessControlService.FilterReadable(fu.db, principal, root, candidates, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, v := range readable {
+			if len(folders) == limit {
+				if limit == 0 {
+					return folders, &entity.ListCursor{Kind: entity.ListKindFolder}, nil
+				}
+				return folders, entity.NewFolderListCursor(&folders[limit-1], query.Sort), nil
 			}
+			folders = append(folders, v)
 		}
-		return nil
+		if len(candidates) < batch.Limit {
+			return folders, nil, nil
+		}
+		batch.Cursor = entity.NewFolderListCursor(&candidates[len(candidates)-1], query.Sort)
 	}
-	if err := compress(w, folderBody, "/"+folderInfo.Name.Value+"/"); err != nil {
-		return nil, err
+}
+
+func (fu *folderUsecase) searchFiles(root *entity.FolderInfo, query *entity.ListQuery, limit int, principal entity.Principal) ([]entity.FileInfo, *entity.ListCursor, error) {
+	batch := query.With(entity.ListKindFile, query.Limit+1)
+	var files []entity.FileInfo
+	for {
+		candidates, err := fu.fileInfoRepository.Search(fu.db, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, readable, err := fu.accessControlService.FilterReadable(fu.db, principal, root, nil, candidates)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, v := range readable {
+			if len(files) == limit {
+				if limit == 0 {
+					return files, &entity.ListCursor{Kind: entity.ListKindFile}, nil
+				}
+				return files, entity.NewFileListCursor(&files[limit-1], query.Sort), nil
+			}
+			files = append(files, v)
+		}
+		if len(candidates) < batch.Limit {
+			return files, nil, nil
+		}
+		batch.Cursor = entity.NewFileListCursor(&candidates[len(candidates)-1], query.Sort)
 	}
+}
 
-	if err := w.Close(); err != nil {
-		return nil, err
+func (fu *folderUsecase) authorizeList(id uint64, filter *entity.ListFilter, principal entity.Principal) error {
+	folderInfo, err := fu.folderInfoRepository.FindOneByID(fu.db, id)
+	if err != nil {
+		return err
+	}
+
+	if err := fu.authorize(fu.db, principal, folderInfo, entity.PermissionRead); err != nil {
+		return err
+	}
+
+	visibility, err := fu.accessControlService.Visibility(fu.db, principal, folderInfo)
+	if err != nil {
+		return err
 	}
+	filter.Visibility = *visibility
+	return nil
+}
 
-	return dto.NewFolderBodyDTO("application/zip", buf.Bytes()), nil
+func (fu *folderUsecase) authorize(db *gorm.DB, principal entity.Principal, folder *entity.FolderInfo, required entity.Permission) error {
+	permission, err := fu.accessControlService.FolderPermission(db, principal, folder)
+	if err != nil {
+		return err
+	}
+	return authorize(permission, required)
+}
+
+func (fu *folderUsecase) convertToFolderListDTO(folders []entity.FolderInfo, files []entity.FileInfo, next *entity.ListCursor) *dto.FolderListDTO {
+	folderDTOs := make([]dto.FolderInfoDTO, len(folders))
+	for i, v := range folders {
+		folderDTOs[i] = *fu.convertToFolderInfoDTO(&v)
+	}
+	fileDTOs := make([]dto.FileInfoDTO, len(files))
+	for i, v := range files {
+		fileDTOs[i] = *dto.NewFileInfoDTO(v.ID, v.FolderID, v.OwnerID, v.Name.Value, v.Path.Value, v.MimeType.Value, v.Size, v.IsHide, v.CreatedAt, v.UpdatedAt)
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.String()
+	}
+	return dto.NewFolderListDTO(folderDTOs, fileDTOs, nextCursor)
 }
 
 func (fu *folderUsecase) convertToFolderInfoDTO(folder *entity.FolderInfo) *dto.FolderInfoDTO {
@@ -339,8 +869,8 @@ func (fu *folderUsecase) convertToFolderInfoDTO(folder *entity.FolderInfo) *dto.
 
 	files := make([]dto.FileInfoDTO, len(folder.Files))
 	for i, v := range folder.Files {
-		files[i] = *dto.NewFileInfoDTO(v.ID, v.FolderID, v.Name.Value, v.Path.Value, v.MimeType.Value, v.IsHide, v.CreatedAt, v.UpdatedAt)
+		files[i] = *dto.NewFileInfoDTO(v.ID, v.FolderID, v.OwnerID, v.Name.Value, v.Path.Value, v.MimeType.Value, v.Size, v.IsHide, v.CreatedAt, v.UpdatedAt)
 	}
 
-	return dto.NewFolderInfoDTO(folder.ID, folder.ParentFolderID, folder.Name.Value, folder.Path.Value, folder.IsHide, folders, files, folder.CreatedAt, folder.UpdatedAt)
+	return dto.NewFolderInfoDTO(folder.ID, folder.ParentFolderID, folder.OwnerID, folder.Name.Value, folder.Path.Value, folder.IsHide, folders, files, folder.CreatedAt, folder.UpdatedAt)
 }
@@ -1,13 +1,23 @@
 package usecase
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"file-server/internal/app/api/domain/entity"
 	"file-server/test/database"
 	mock_repository "file-server/test/mock/domain/repository"
 	mock_service "file-server/test/mock/domain/service"
+	"io"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
 )
 
 func TestCreateFolder(t *testing.T) {
@@ -30,15 +40,29 @@ func TestCreateFolder(t *testing.T) {
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 	folderInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Create(gomock.Any()).Return(nil)
+	folderBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
-	folderInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	result, err := fu.Create(1, folderInfo.Name.Value, folderInfo.IsHide)
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Create(1, folderInfo.Name.Value, folderInfo.IsHide, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -68,18 +92,32 @@ func TestUpdateFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
-	folderInfoRepository.EXPECT().FindOneByIDAndIsHideWithLower(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 	folderInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	folderBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
-	folderInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	trashService := mock_service.NewMockTrashService(ctrl)
 
-	result, err := fu.Update(folderInfo.ID, "update", false, false)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Update(folderInfo.ID, "update", false, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -109,22 +147,82 @@ func TestRemoveFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
-	folderInfoRepository.EXPECT().FindOneByIDAndIsHideWithLower(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
-	folderInfoRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
 
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Remove(gomock.Any()).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
 
-	err = fu.Remove(folderInfo.ID, false)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	trashService.EXPECT().TrashFolder(gomock.Any(), uint64(1), folderInfo).Return(&entity.TrashItem{ID: 1}, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	err = fu.Remove(folderInfo.ID, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
 }
 
+func TestRemoveFolderWithoutPermission(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 2
+	var parentFolderID uint64 = 1
+	folderInfo.ParentFolderID = &parentFolderID
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 2}, gomock.Any()).Return(entity.PermissionRead, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	if err := fu.Remove(folderInfo.ID, entity.Principal{UserID: 2}); !errors.Is(err, entity.ErrPermissionDenied) {
+		t.Error("folder is removed without permission")
+	}
+}
+
 func TestMoveFolder(t *testing.T) {
 	db, mock, err := database.Open()
 	if err != nil {
@@ -152,18 +250,32 @@ func TestMoveFolder(t *testing.T) {
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(parentFolderInfo, nil)
-	folderInfoRepository.EXPECT().FindOneByIDAndIsHideWithLower(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 	folderInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	folderBodyRepository.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
-	folderInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil).Times(2)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	result, err := fu.Move(folderInfo.ID, 1, false)
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Move(folderInfo.ID, 1, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -195,26 +307,38 @@ func TestCopyFolder(t *testing.T) {
 	}
 	folderInfo.ID = 1
 
-	folderBody := entity.NewFolderBody("/path/name/")
-
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
 	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(parentFolderInfo, nil)
-	folderInfoRepository.EXPECT().FindOneByIDAndIsHideWithLower(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 	folderInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
 
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Read(gomock.Any()).Return(folderBody, nil)
-	folderBodyRepository.EXPECT().Create(gomock.Any()).Return(nil)
+	folderBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
-	folderInfoService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictFail).Return(nil, nil)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil).Times(2)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
 
-	result, err := fu.Copy(folderInfo.ID, 1, false)
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Copy(folderInfo.ID, 1, entity.ConflictFail, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -240,15 +364,30 @@ func TestFindOneFolder(t *testing.T) {
 	defer ctrl.Finish()
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
-	folderInfoRepository.EXPECT().FindOneByPathAndIsHideWithChildren(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
 
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
 
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	result, err := fu.FindOne(folderInfo.Path.Value, false)
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.FindOne(folderInfo.Path.Value, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -258,6 +397,48 @@ func TestFindOneFolder(t *testing.T) {
 	}
 }
 
+func TestFindOneHiddenFolderWithoutPermission(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{}, gomock.Any()).Return(entity.PermissionNone, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	if _, err := fu.FindOne(folderInfo.Path.Value, entity.Principal{}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Error("hidden folder is found without permission")
+	}
+}
+
 func TestReadFolder(t *testing.T) {
 	db, _, err := database.Open()
 	if err != nil {
@@ -270,22 +451,53 @@ func TestReadFolder(t *testing.T) {
 	}
 	folderInfo.ID = 1
 
-	folderBody := entity.NewFolderBody("/path/name/")
+	fileInfo, err := entity.NewFileInfo(1, "file", "/path/name/file", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.UpdatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	folderInfo.Files = []entity.FileInfo{*fileInfo}
+
+	fileBody, err := os.CreateTemp(t.TempDir(), "file")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := fileBody.WriteString("file"); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := fileBody.Seek(0, io.SeekStart); err != nil {
+		t.Error(err.Error())
+	}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
-	folderInfoRepository.EXPECT().FindOneByIDAndIsHideWithLower(gomock.Any(), gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
 
 	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
-	folderBodyRepository.EXPECT().Read(gomock.Any()).Return(folderBody, nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Read(fileInfo.Path.Value).Return(fileBody, nil)
 
 	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
 
-	fu := NewFolderUsecase(db, folderInfoRepository, folderBodyRepository, folderInfoService)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
 
-	result, err := fu.Read(folderInfo.ID, false)
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Read(context.Background(), folderInfo.ID, entity.Principal{UserID: 1})
 	if err != nil {
 		t.Error(err.Error())
 	}
@@ -293,4 +505,676 @@ func TestReadFolder(t *testing.T) {
 	if result == nil {
 		t.Error("failed to read folder")
 	}
+
+	body, err := io.ReadAll(result.Body)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	r, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(r.File) != 2 || r.File[1].Name != "name/file" || !r.File[1].Modified.Equal(fileInfo.UpdatedAt) {
+		t.Error("failed to compress folder")
+	}
+}
+
+func TestArchive(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 2
+
+	innerFileInfo, err := entity.NewFileInfo(2, "inner", "/path/name/inner", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.Files = []entity.FileInfo{*innerFileInfo}
+
+	fileInfo, err := entity.NewFileInfo(1, "file", "/path/file", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	bodies := make(map[string]*os.File)
+	for _, v := range []string{innerFileInfo.Path.Value, fileInfo.Path.Value} {
+		f, err := os.CreateTemp(t.TempDir(), "file")
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if _, err := f.WriteString("body"); err != nil {
+			t.Error(err.Error())
+		}
+		bodies[v] = f
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Read(innerFileInfo.Path.Value).Return(bodies[innerFileInfo.Path.Value], nil)
+	fileBodyRepository.EXPECT().Read(fileInfo.Path.Value).Return(bodies[fileInfo.Path.Value], nil)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Archive(context.Background(), []uint64{folderInfo.ID}, []uint64{fileInfo.ID, fileInfo.ID}, entity.ArchiveTarGzip, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.Name != "archive.tar.gz" || result.MimeType != "application/gzip" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	gr, err := gzip.NewReader(result.Body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	tr := tar.NewReader(gr)
+	names := []string{}
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if header.Typeflag == tar.TypeReg && header.Size != 4 {
+			t.Errorf("unexpected size: %s %d", header.Name, header.Size)
+		}
+		names = append(names, header.Name)
+	}
+	if len(names) != 3 || names[0] != "name/" || names[1] != "name/inner" || names[2] != "file" {
+		t.Errorf("unexpected entries: %v", names)
+	}
+}
+
+func TestArchiveWithNameConflict(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 2
+
+	fileInfo, err := entity.NewFileInfo(1, "name", "/other/name", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	var conflictErr *entity.ConflictError
+	if _, err := fu.Archive(context.Background(), []uint64{folderInfo.ID}, []uint64{fileInfo.ID}, entity.ArchiveZip, entity.Principal{UserID: 1}); !errors.As(err, &conflictErr) || conflictErr.Path != fileInfo.Path.Value {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCopyFolderWithMerge(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	parentFolderInfo, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	parentFolderInfo.ID = 1
+
+	sourceFileInfo, err := entity.NewFileInfo(2, "a.txt", "/path/name/a.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sourceFolderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sourceFolderInfo.ID = 2
+	sourceFolderInfo.Files = []entity.FileInfo{*sourceFileInfo}
+
+	targetFileInfo, err := entity.NewFileInfo(3, "b.txt", "/name/b.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	targetFolderInfo, err := entity.NewFolderInfo(&parentFolderInfo.ID, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	targetFolderInfo.ID = 3
+	targetFolderInfo.Files = []entity.FileInfo{*targetFileInfo}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), uint64(2)).Return(sourceFolderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), uint64(3)).Return(targetFolderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(parentFolderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(3)).Return(targetFolderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		if file.FolderID != 3 || file.Path.Value != "/name/a.txt" {
+			t.Error("failed to copy file into merged folder")
+		}
+		return file, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Copy(gomock.Any(), "/path/name/a.txt", "/name/a.txt").Return(nil)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictMerge).Return(targetFolderInfo, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil).Times(3)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Copy(2, 1, entity.ConflictMerge, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.ID != 3 {
+		t.Error("failed to merge folder")
+	}
+}
+
+func TestCopyFolderWithMergeFileConflict(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	parentFolderInfo, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	parentFolderInfo.ID = 1
+
+	sourceFileInfo, err := entity.NewFileInfo(2, "a.txt", "/path/name/a.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sourceFolderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sourceFolderInfo.ID = 2
+	sourceFolderInfo.Files = []entity.FileInfo{*sourceFileInfo}
+
+	targetFileInfo, err := entity.NewFileInfo(3, "a.txt", "/name/a.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	targetFileInfo.ID = 4
+	targetFolderInfo, err := entity.NewFolderInfo(&parentFolderInfo.ID, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	targetFolderInfo.ID = 3
+	targetFolderInfo.Files = []entity.FileInfo{*targetFileInfo}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), uint64(2)).Return(sourceFolderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByIDWithLower(gomock.Any(), uint64(3)).Return(targetFolderInfo, nil)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(parentFolderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+	folderInfoService.EXPECT().ResolveConflict(gomock.Any(), gomock.Any(), entity.ConflictMerge).Return(targetFolderInfo, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionAdmin, nil).Times(3)
+	accessControlService.EXPECT().Filter(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	_, err = fu.Copy(2, 1, entity.ConflictMerge, entity.Principal{UserID: 1})
+	var conflictErr *entity.ConflictError
+	if !errors.As(err, &conflictErr) || *conflictErr.FileID != 4 {
+		t.Error("failed to detect file conflict")
+	}
+}
+
+func TestListFolder(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	childFolderInfo, err := entity.NewFolderInfo(&folderInfo.ID, "child", "/name/child/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	childFolderInfo.ID = 2
+
+	fileInfo, err := entity.NewFileInfo(1, "file", "/name/file", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 3
+
+	query, err := entity.NewListQuery("name", "asc", "", 2, entity.ListFilter{})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(folderInfo, nil)
+	folderInfoRepository.EXPECT().FindAllByParentFolderID(gomock.Any(), uint64(1), gomock.Any()).DoAndReturn(func(db *gorm.DB, id uint64, query *entity.ListQuery) ([]entity.FolderInfo, error) {
+		if query.Limit != 3 || !query.Filter.Visibility.IncludeHidden {
+			t.Error("failed to pass folder query")
+		}
+		return []entity.FolderInfo{*childFolderInfo}, nil
+	})
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAllByFolderID(gomock.Any(), uint64(1), gomock.Any()).DoAndReturn(func(db *gorm.DB, id uint64, query *entity.ListQuery) ([]entity.FileInfo, error) {
+		if query.Limit != 2 || query.Cursor != nil {
+			t.Error("failed to pass file query")
+		}
+		return []entity.FileInfo{*fileInfo, *fileInfo}, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().Visibility(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(&entity.Visibility{IncludeHidden: true}, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.List(1, query, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result.Folders) != 1 || len(result.Files) != 1 {
+		t.Error("failed to list folder")
+	}
+
+	cursor, err := entity.NewListCursor(result.NextCursor)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if cursor.Kind != entity.ListKindFile || cursor.ID != 3 || cursor.Value != "file" {
+		t.Error("failed to return next cursor")
+	}
+}
+
+func TestListFolderWithMimeType(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	query, err := entity.NewListQuery("size", "desc", "", 10, entity.ListFilter{MimeType: "image/"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindAllByFolderID(gomock.Any(), uint64(1), gomock.Any()).Return([]entity.FileInfo{}, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().Visibility(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(&entity.Visibility{}, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.List(1, query, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result.Folders) != 0 || len(result.Files) != 0 || result.NextCursor != "" {
+		t.Error("failed to list folder")
+	}
+}
+
+func TestCountFolderWithKind(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(folderInfo, nil).Times(2)
+	folderInfoRepository.EXPECT().CountByParentFolderID(gomock.Any(), uint64(1), gomock.Any()).Return(int64(2), nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().CountByFolderID(gomock.Any(), uint64(1), gomock.Any()).Return(int64(3), nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil).Times(2)
+	accessControlService.EXPECT().Visibility(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(&entity.Visibility{}, nil).Times(2)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Count(1, &entity.ListFilter{Kind: entity.ListKindFolder}, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if result.Folders != 2 || result.Files != 0 {
+		t.Errorf("unexpected count: %+v", result)
+	}
+
+	result, err = fu.Count(1, &entity.ListFilter{Kind: entity.ListKindFile}, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if result.Folders != 0 || result.Files != 3 {
+		t.Errorf("unexpected count: %+v", result)
+	}
+}
+
+func TestSearchFolder(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	visible, err := entity.NewFolderInfo(nil, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	visible.ID = 2
+
+	hidden, err := entity.NewFolderInfo(nil, "name", "/hidden/name/", true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	hidden.ID = 3
+
+	file, err := entity.NewFileInfo(2, "name.txt", "/name/name.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	file.ID = 4
+
+	query, err := entity.NewListQuery("name", "asc", "", 10, entity.ListFilter{Name: "name"})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(root, nil)
+	folderInfoRepository.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, query *entity.ListQuery) ([]entity.FolderInfo, error) {
+		if query.Filter.Path != "/" || !query.Filter.Visibility.IncludeHidden || query.Limit != 11 {
+			t.Error("failed to build search query")
+		}
+		return []entity.FolderInfo{*hidden, *visible}, nil
+	})
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Search(gomock.Any(), gomock.Any()).Return([]entity.FileInfo{*file}, nil)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().FilterReadable(gomock.Any(), entity.Principal{UserID: 1}, root, []entity.FolderInfo{*hidden, *visible}, nil).Return([]entity.FolderInfo{*visible}, []entity.FileInfo{}, nil)
+	accessControlService.EXPECT().FilterReadable(gomock.Any(), entity.Principal{UserID: 1}, root, nil, []entity.FileInfo{*file}).Return([]entity.FolderInfo{}, []entity.FileInfo{*file}, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Search(1, query, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result.Folders) != 1 || result.Folders[0].ID != 2 || len(result.Files) != 1 || result.Files[0].ID != 4 || result.NextCursor != "" {
+		t.Error("failed to search folder")
+	}
+}
+
+func TestSearchFolderWithNextCursor(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	hidden, err := entity.NewFolderInfo(nil, "a", "/a/", true)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	hidden.ID = 2
+
+	first, err := entity.NewFolderInfo(nil, "b", "/b/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	first.ID = 3
+
+	second, err := entity.NewFolderInfo(nil, "c", "/c/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	second.ID = 4
+
+	query, err := entity.NewListQuery("name", "asc", "", 1, entity.ListFilter{Kind: entity.ListKindFolder})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), uint64(1)).Return(root, nil)
+	gomock.InOrder(
+		folderInfoRepository.EXPECT().Search(gomock.Any(), gomock.Any()).Return([]entity.FolderInfo{*hidden, *first}, nil),
+		folderInfoRepository.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, query *entity.ListQuery) ([]entity.FolderInfo, error) {
+			if query.Cursor == nil || query.Cursor.ID != 3 {
+				t.Error("failed to advance search cursor")
+			}
+			return []entity.FolderInfo{*second}, nil
+		}),
+	)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+
+	folderInfoService := mock_service.NewMockFolderInfoService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, gomock.Any()).Return(entity.PermissionRead, nil)
+	accessControlService.EXPECT().FilterReadable(gomock.Any(), entity.Principal{UserID: 1}, root, []entity.FolderInfo{*hidden, *first}, nil).Return([]entity.FolderInfo{*first}, []entity.FileInfo{}, nil)
+	accessControlService.EXPECT().FilterReadable(gomock.Any(), entity.Principal{UserID: 1}, root, []entity.FolderInfo{*second}, nil).Return([]entity.FolderInfo{*second}, []entity.FileInfo{}, nil)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	fu := NewFolderUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, folderInfoService, accessControlService, trashService, unitOfWork)
+
+	result, err := fu.Search(1, query, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result.Folders) != 1 || result.Folders[0].ID != 3 || len(result.Files) != 0 || result.NextCursor == "" {
+		t.Error("failed to search folder")
+	}
 }
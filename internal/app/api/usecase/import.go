@@ -0,0 +1,365 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const importBatchSize = 500
+
+type ImportUsecase interface {
+	Import(fs.FS, string, bool) (*dto.ImportDTO, error)
+}
+
+type importUsecase struct {
+	db                   *gorm.DB
+	folderInfoRepository repository.FolderInfoRepository
+	fileInfoRepository   repository.FileInfoRepository
+	folderBodyRepository repository.FolderBodyRepository
+	fileBodyRepository   repository.FileBodyRepository
+	fileVersionService   service.FileVersionService
+	unitOfWork           service.UnitOfWork
+}
+
+func NewImportUsecase(db *gorm.DB, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, folderBodyRepository repository.FolderBodyRepository, fileBodyRepository repository.FileBodyRepository, fileVersionService service.FileVersionService, unitOfWork service.UnitOfWork) ImportUsecase {
+	return &importUsecase{
+		db:                   db,
+		folderInfoRepository: folderInfoRepository,
+		fileInfoRepository:   fileInfoRepository,
+		folderBodyRepository: folderBodyRepository,
+		fileBodyRepository:   fileBodyRepository,
+		fileVersionService:   fileVersionService,
+		unitOfWork:           unitOfWork,
+	}
+}
+
+type importEntry struct {
+	source   string
+	size     int64
+	mimeType string
+	fileInfo *entity.FileInfo
+	isNew    bool
+}
+
+type importResult struct {
+	createdFolders int
+	createdFiles   int
+	updatedFiles   int
+	unchangedFiles int
+	failures       []dto.ImportFailureDTO
+}
+
+func (r *importResult) fail(source string, err error) {
+	r.failures = append(r.failures, *dto.NewImportFailureDTO(source, err.Error()))
+}
+
+type importFileError struct {
+	err error
+}
+
+func (e *importFileError) Error() string {
+	return e.err.Error()
+}
+
+func (e *importFileError) Unwrap() error {
+	return e.err
+}
+
+type importSource struct {
+	r   io.Reader
+	err error
+}
+
+func (s *importSource) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		s.err = err
+	}
+	return n, err
+}
+
+func (iu *importUsecase) Import(fsys fs.FS, folderPath string, adopt bool) (*dto.ImportDTO, error) {
+	folderInfo, err := iu.findOrCreateFolder(folderPath, adopt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &importResult{failures: []dto.ImportFailureDTO{}}
+	if err := iu.importFolder(fsys, ".", folderInfo, adopt, result); err != nil {
+		return nil, err
+	}
+
+	return dto.NewImportDTO(result.createdFolders, result.createdFiles, result.updatedFiles, result.unchangedFiles, result.failures), nil
+}
+
+func (iu *importUsecase) findOrCreateFolder(folderPath string, adopt bool) (*entity.FolderInfo, error) {
+	folderInfo, err := iu.folderInfoRepository.FindOneByPath(iu.db, folderPath)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return folderInfo, err
+	}
+
+	if err := iu.unitOfWork.Transaction(iu.db, func(tx *gorm.DB) error {
+		parentFolder, err := iu.folderInfoRepository.FindOneByPath(tx, entity.ParentPath(folderPath))
+		if err != nil {
+			return err
+		}
+
+		folderInfo, err = entity.NewFolderInfo(&parentFolder.ID, entity.BaseName(folderPath), folderPath, false)
+		if err != nil {
+			return err
+		}
+		folderInfo.OwnerID = parentFolder.OwnerID
+
+		folderInfo, err = iu.folderInfoRepository.Create(tx, folderInfo)
+		if err != nil {
+			return err
+		}
+		if adopt {
+			return nil
+		}
+		return iu.folderBodyRepository.Create(tx, entity.NewFolderBody(folderInfo.Path.Value))
+	}); err != nil {
+		return nil, err
+	}
+	return folderInfo, nil
+}
+
+func (iu *importUsecase) importFolder(fsys fs.FS, dir string, folderInfo *entity.FolderInfo, adopt bool, result *importResult) error {
+	dirEntries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if dir == "." {
+			return err
+		}
+		result.fail(dir, err)
+		return nil
+	}
+
+	parentFolder, err := iu.folderInfoRepository.FindOneByPathWithChildren(iu.db, folderInfo.Path.Value)
+	if err != nil {
+		return err
+	}
+	folders := make(map[string]entity.FolderInfo, len(parentFolder.Folders))
+	for _, v := range parentFolder.Folders {
+		folders[v.Name.Value] = v
+	}
+	files := make(map[string]entity.FileInfo, len(parentFolder.Files))
+	for _, v := range parentFolder.Files {
+		files[v.Name.Value] = v
+	}
+
+	subdirs := []string{}
+	newFolders := []entity.FolderInfo{}
+	entries := []importEntry{}
+	for _, v := range dirEntries {
+		name := v.Name()
+		source := path.Join(dir, name)
+		switch {
+		case v.IsDir():
+			if adopt && entity.IsReservedPath(parentFolder.Path.Value+name+"/") {
+				continue
+			}
+			if _, ok := folders[name]; !ok {
+				folder, err := entity.NewFolderInfo(&parentFolder.ID, name, parentFolder.Path.Value+name+"/", false)
+				if err != nil {
+					result.fail(source, err)
+					continue
+				}
+				folder.OwnerID = parentFolder.OwnerID
+				newFolders = append(newFolders, *folder)
+			}
+			subdirs = append(subdirs, name)
+		case v.Type().IsRegular():
+			if adopt && strings.HasPrefix(name, ".upload-") {
+				continue
+			}
+			info, err := v.Info()
+			if err != nil {
+				result.fail(source, err)
+				continue
+			}
+
+			if existing, ok := files[name]; ok {
+				if existing.Size == info.Size() && !info.ModTime().After(existing.UpdatedAt) {
+					result.unchangedFiles++
+					continue
+				}
+				entries = append(entries, importEntry{source: source, size: info.Size(), fileInfo: &existing})
+				continue
+			}
+
+			fileInfo, err := entity.NewFileInfo(parentFolder.ID, name, parentFolder.Path.Value+name, "application/octet-stream", false)
+			if err != nil {
+				result.fail(source, err)
+				continue
+			}
+			fileInfo.OwnerID = parentFolder.OwnerID
+			entries = append(entries, importEntry{source: source, size: info.Size(), fileInfo: fileInfo, isNew: true})
+		}
+	}
+
+	if 0 < len(newFolders) {
+		if err := iu.unitOfWork.Transaction(iu.db, func(tx *gorm.DB) error {
+			created, err := iu.folderInfoRepository.Creates(tx, newFolders)
+			if err != nil {
+				return err
+			}
+			for _, v := range created {
+				folders[v.Name.Value] = v
+				if adopt {
+					continue
+				}
+				if err := iu.folderBodyRepository.Create(tx, entity.NewFolderBody(v.Path.Value)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		result.createdFolders += len(newFolders)
+	}
+
+	for i := 0; i < len(entries); i += importBatchSize {
+		if err := iu.importFiles(fsys, entries[i:min(i+importBatchSize, len(entries))], adopt, result); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range subdirs {
+		folder := folders[v]
+		if err := iu.importFolder(fsys, path.Join(dir, v), &folder, adopt, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (iu *importUsecase) importFiles(fsys fs.FS, entries []importEntry, adopt bool, result *importResult) error {
+	readable := make([]importEntry, 0, len(entries))
+	for _, v := range entries {
+		mimeType, err := iu.readMimeType(fsys, v.source)
+		if err != nil {
+			result.fail(v.source, err)
+			continue
+		}
+		v.mimeType = mimeType
+		readable = append(readable, v)
+	}
+
+	err := iu.importBatch(fsys, readable, adopt, result)
+	var fileErr *importFileError
+	if !errors.As(err, &fileErr) || errors.Is(err, entity.ErrStorage) {
+		return err
+	}
+
+	for _, v := range readable {
+		err := iu.importBatch(fsys, []importEntry{v}, adopt, result)
+		if errors.As(err, &fileErr) && !errors.Is(err, entity.ErrStorage) {
+			result.fail(v.source, fileErr.err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (iu *importUsecase) importBatch(fsys fs.FS, entries []importEntry, adopt bool, result *importResult) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	newFiles := []entity.FileInfo{}
+	if err := iu.unitOfWork.Transaction(iu.db, func(tx *gorm.DB) error {
+		for _, v := range entries {
+			fileInfo, err := iu.importFile(tx, fsys, &v, adopt)
+			if err != nil {
+				return err
+			}
+			if v.isNew {
+				newFiles = append(newFiles, *fileInfo)
+			}
+		}
+		if len(newFiles) == 0 {
+			return nil
+		}
+		_, err := iu.fileInfoRepository.Creates(tx, newFiles)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	result.createdFiles += len(newFiles)
+	result.updatedFiles += len(entries) - len(newFiles)
+	return nil
+}
+
+func (iu *importUsecase) readMimeType(fsys fs.FS, source string) (string, error) {
+	f, err := fsys.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	mimeType, _, err := detectMimeType(f)
+	if err != nil {
+		return "", err
+	}
+	if _, err := entity.NewMimeType(mimeType); err != nil {
+		return "", err
+	}
+	return mimeType, nil
+}
+
+func (iu *importUsecase) importFile(db *gorm.DB, fsys fs.FS, entry *importEntry, adopt bool) (*entity.FileInfo, error) {
+	fileInfo := *entry.fileInfo
+	if !adopt && !entry.isNew {
+		if _, err := iu.fileVersionService.Archive(db, &fileInfo); err != nil {
+			return nil, err
+		}
+	}
+	if err := fileInfo.SetMimeType(entry.mimeType); err != nil {
+		return nil, err
+	}
+	fileInfo.Size = entry.size
+
+	if !adopt {
+		f, err := fsys.Open(entry.source)
+		if err != nil {
+			return nil, &importFileError{err: err}
+		}
+		defer f.Close()
+
+		source := &importSource{r: f}
+		fileBody := entity.NewFileBody(fileInfo.Path.Value, source)
+		if err := iu.fileBodyRepository.Create(db, fileBody); err != nil {
+			if source.err != nil {
+				return nil, &importFileError{err: source.err}
+			}
+			return nil, err
+		}
+		fileInfo.Size = fileBody.Size()
+	}
+	if entry.isNew {
+		return &fileInfo, nil
+	}
+
+	updated, err := iu.fileInfoRepository.Update(db, &fileInfo)
+	if err != nil {
+		return nil, err
+	}
+	if adopt {
+		return updated, nil
+	}
+	return updated, iu.fileVersionService.Prune(db, updated.ID)
+}
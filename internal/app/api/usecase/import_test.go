@@ -0,0 +1,486 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestImport(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	for i := 0; i < 3; i++ {
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+	}
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+	same, err := entity.NewFileInfo(1, "same.txt", "/same.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	same.ID = 1
+	same.Size = 4
+	same.UpdatedAt = time.Now()
+	changed, err := entity.NewFileInfo(1, "changed.txt", "/changed.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	changed.ID = 2
+	changed.Size = 1
+	changed.UpdatedAt = time.Now()
+	parent := *root
+	parent.Files = []entity.FileInfo{*same, *changed}
+
+	parentFolderID := root.ID
+	sub, err := entity.NewFolderInfo(&parentFolderID, "sub", "/sub/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	sub.ID = 2
+
+	fsys := fstest.MapFS{
+		"same.txt":     {Data: []byte("same"), ModTime: time.Now().Add(-time.Hour)},
+		"changed.txt":  {Data: []byte("changed"), ModTime: time.Now().Add(-time.Hour)},
+		"new.txt":      {Data: []byte("new"), ModTime: time.Now()},
+		"bad:name.txt": {Data: []byte("bad"), ModTime: time.Now()},
+		"sub/file.txt": {Data: []byte("file"), ModTime: time.Now()},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/").Return(&parent, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/sub/").Return(sub, nil)
+	folderInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, folders []entity.FolderInfo) ([]entity.FolderInfo, error) {
+		if len(folders) != 1 || folders[0].Path.Value != "/sub/" || *folders[0].ParentFolderID != root.ID {
+			t.Errorf("unexpected folders: %+v", folders)
+		}
+		return []entity.FolderInfo{*sub}, nil
+	})
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileInfo) (*entity.FileInfo, error) {
+		if file.ID != changed.ID || file.Size != 7 {
+			t.Errorf("unexpected file: %+v", file)
+		}
+		return file, nil
+	})
+	gomock.InOrder(
+		fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+			if len(files) != 1 || files[0].Path.Value != "/new.txt" || files[0].Size != 3 || files[0].MimeType.Value != "text/plain; charset=utf-8" {
+				t.Errorf("unexpected files: %+v", files)
+			}
+			return files, nil
+		}),
+		fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+			if len(files) != 1 || files[0].Path.Value != "/sub/file.txt" || files[0].FolderID != sub.ID {
+				t.Errorf("unexpected files: %+v", files)
+			}
+			return files, nil
+		}),
+	)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	folderBodyRepository.EXPECT().Create(gomock.Any(), entity.NewFolderBody("/sub/")).Return(nil)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileBody) error {
+		_, err := io.Copy(io.Discard, file.Body)
+		return err
+	}).Times(3)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+	fileVersionService.EXPECT().Archive(gomock.Any(), gomock.Any()).Return(nil, nil)
+	fileVersionService.EXPECT().Prune(gomock.Any(), changed.ID).Return(nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	result, err := iu.Import(fsys, "/", false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.CreatedFolders != 1 || result.CreatedFiles != 2 || result.UpdatedFiles != 1 || result.UnchangedFiles != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Path != "bad:name.txt" {
+		t.Errorf("unexpected failures: %+v", result.Failures)
+	}
+}
+
+type failingFS struct {
+	fstest.MapFS
+}
+
+func (f failingFS) Open(name string) (fs.File, error) {
+	switch name {
+	case "broken.txt":
+		return nil, fs.ErrPermission
+	case "partial.txt":
+		file, err := f.MapFS.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		return &failingFile{File: file}, nil
+	}
+	return f.MapFS.Open(name)
+}
+
+type failingFile struct {
+	fs.File
+	read int
+}
+
+func (f *failingFile) Read(p []byte) (int, error) {
+	if 512 <= f.read {
+		return 0, errors.New("input/output error")
+	}
+	n, err := f.File.Read(p[:min(len(p), 512-f.read)])
+	f.read += n
+	return n, err
+}
+
+func TestImportWithUnreadableFile(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	fsys := failingFS{fstest.MapFS{
+		"a.txt":      {Data: []byte("a"), ModTime: time.Now()},
+		"broken.txt": {Data: []byte("broken"), ModTime: time.Now()},
+	}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/").Return(root, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+		if len(files) != 1 || files[0].Path.Value != "/a.txt" {
+			t.Errorf("unexpected files: %+v", files)
+		}
+		return files, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	result, err := iu.Import(fsys, "/", false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.CreatedFiles != 1 || len(result.Failures) != 1 || result.Failures[0].Path != "broken.txt" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestImportWithReadError(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	fsys := failingFS{fstest.MapFS{
+		"a.txt":       {Data: []byte("a"), ModTime: time.Now()},
+		"broken.txt":  {Data: []byte("broken"), ModTime: time.Now()},
+		"c.txt":       {Data: []byte("c"), ModTime: time.Now()},
+		"partial.txt": {Data: bytes.Repeat([]byte("partial"), 100), ModTime: time.Now()},
+	}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/").Return(root, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	gomock.InOrder(
+		fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+			if len(files) != 1 || files[0].Path.Value != "/a.txt" {
+				t.Errorf("unexpected files: %+v", files)
+			}
+			return files, nil
+		}),
+		fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+			if len(files) != 1 || files[0].Path.Value != "/c.txt" {
+				t.Errorf("unexpected files: %+v", files)
+			}
+			return files, nil
+		}),
+	)
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, file *entity.FileBody) error {
+		if _, err := io.Copy(io.Discard, file.Body); err != nil {
+			return fmt.Errorf("%w: %w", entity.ErrStorage, err)
+		}
+		return nil
+	}).Times(6)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	result, err := iu.Import(fsys, "/", false)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.CreatedFiles != 2 || result.UpdatedFiles != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(result.Failures) != 2 || result.Failures[0].Path != "broken.txt" || result.Failures[1].Path != "partial.txt" {
+		t.Errorf("unexpected failures: %+v", result.Failures)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestImportWithStorageError(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	fsys := fstest.MapFS{
+		"a.txt": {Data: []byte("a"), ModTime: time.Now()},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/").Return(root, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileBodyRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entity.ErrStorageQuotaExceeded)
+
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	if _, err := iu.Import(fsys, "/", false); !errors.Is(err, entity.ErrStorageQuotaExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestImportAdopt(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+	parentFolderID := root.ID
+	share, err := entity.NewFolderInfo(&parentFolderID, "share", "/share/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	share.ID = 2
+
+	fsys := fstest.MapFS{
+		"file.txt":  {Data: []byte("file")},
+		".upload-1": {Data: []byte("tmp")},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/share/").Return(nil, gorm.ErrRecordNotFound)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(share, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/share/").Return(share, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+		if len(files) != 1 || files[0].Path.Value != "/share/file.txt" || files[0].Size != 4 {
+			t.Errorf("unexpected files: %+v", files)
+		}
+		return files, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	result, err := iu.Import(fsys, "/share/", true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.CreatedFiles != 1 || len(result.Failures) != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestImportAdoptRoot(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	root, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	root.ID = 1
+
+	fsys := fstest.MapFS{
+		"file.txt":          {Data: []byte("file")},
+		":trash/1/name.txt": {Data: []byte("trash")},
+		":versions/2/3":     {Data: []byte("version")},
+		".upload-1":         {Data: []byte("tmp")},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/").Return(root, nil)
+	folderInfoRepository.EXPECT().FindOneByPathWithChildren(gomock.Any(), "/").Return(root, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().Creates(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, files []entity.FileInfo) ([]entity.FileInfo, error) {
+		if len(files) != 1 || files[0].Path.Value != "/file.txt" {
+			t.Errorf("unexpected files: %+v", files)
+		}
+		return files, nil
+	})
+
+	folderBodyRepository := mock_repository.NewMockFolderBodyRepository(ctrl)
+	fileBodyRepository := mock_repository.NewMockFileBodyRepository(ctrl)
+	fileVersionService := mock_service.NewMockFileVersionService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	iu := NewImportUsecase(db, folderInfoRepository, fileInfoRepository, folderBodyRepository, fileBodyRepository, fileVersionService, unitOfWork)
+
+	result, err := iu.Import(fsys, "/", true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if result.CreatedFolders != 0 || result.CreatedFiles != 1 || len(result.Failures) != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ShareLinkUsecase interface {
+	Create(*uint64, *uint64, string, *time.Time, *uint64, entity.Principal) (*dto.ShareLinkDTO, error)
+	FindAll(entity.Principal) ([]dto.ShareLinkDTO, error)
+	Remove(uint64, entity.Principal) error
+	Open(string, string) (*dto.ShareLinkDTO, error)
+	Download(string) error
+}
+
+type shareLinkUsecase struct {
+	db                   *gorm.DB
+	shareLinkRepository  repository.ShareLinkRepository
+	folderInfoRepository repository.FolderInfoRepository
+	fileInfoRepository   repository.FileInfoRepository
+	userRepository       repository.UserRepository
+	accessControlService service.AccessControlService
+}
+
+func NewShareLinkUsecase(db *gorm.DB, shareLinkRepository repository.ShareLinkRepository, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, userRepository repository.UserRepository, accessControlService service.AccessControlService) ShareLinkUsecase {
+	return &shareLinkUsecase{
+		db:                   db,
+		shareLinkRepository:  shareLinkRepository,
+		folderInfoRepository: folderInfoRepository,
+		fileInfoRepository:   fileInfoRepository,
+		userRepository:       userRepository,
+		accessControlService: accessControlService,
+	}
+}
+
+func (su *shareLinkUsecase) Create(fileID *uint64, folderID *uint64, password string, expiresAt *time.Time, maxDownloads *uint64, principal entity.Principal) (*dto.ShareLinkDTO, error) {
+	if principal.UserID == 0 {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	var shareLink *entity.ShareLink
+	if err := su.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		shareLink, err = entity.NewShareLink(principal.UserID, fileID, folderID, password, expiresAt, maxDownloads)
+		if err != nil {
+			return err
+		}
+
+		var permission entity.Permission
+		if fileID != nil {
+			fileInfo, err := su.fileInfoRepository.FindOneByID(tx, *fileID)
+			if err != nil {
+				return err
+			}
+			permission, err = su.accessControlService.FilePermission(tx, principal, fileInfo)
+			if err != nil {
+				return err
+			}
+		} else {
+			folderInfo, err := su.folderInfoRepository.FindOneByID(tx, *folderID)
+			if err != nil {
+				return err
+			}
+			permission, err = su.accessControlService.FolderPermission(tx, principal, folderInfo)
+			if err != nil {
+				return err
+			}
+		}
+		if err := authorize(permission, entity.PermissionShare); err != nil {
+			return err
+		}
+
+		shareLink, err = su.shareLinkRepository.Create(tx, shareLink)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return su.convertToShareLinkDTO(shareLink), nil
+}
+
+func (su *shareLinkUsecase) FindAll(principal entity.Principal) ([]dto.ShareLinkDTO, error) {
+	if principal.UserID == 0 {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	shareLinks, err := su.shareLinkRepository.FindAllByUserID(su.db, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.ShareLinkDTO, len(shareLinks))
+	for i, v := range shareLinks {
+		dtos[i] = *su.convertToShareLinkDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (su *shareLinkUsecase) Remove(id uint64, principal entity.Principal) error {
+	if principal.UserID == 0 {
+		return entity.ErrPermissionDenied
+	}
+
+	return su.db.Transaction(func(tx *gorm.DB) error {
+		shareLink, err := su.shareLinkRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+		if shareLink.UserID != principal.UserID {
+			return gorm.ErrRecordNotFound
+		}
+
+		return su.shareLinkRepository.Remove(tx, shareLink)
+	})
+}
+
+func (su *shareLinkUsecase) Open(token string, password string) (*dto.ShareLinkDTO, error) {
+	shareLink, err := su.shareLinkRepository.FindOneByToken(su.db, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := shareLink.Authenticate(password, time.Now()); err != nil {
+		return nil, err
+	}
+
+	user, err := su.userRepository.FindOneByID(su.db, shareLink.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDisabled {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return su.convertToShareLinkDTO(shareLink), nil
+}
+
+func (su *shareLinkUsecase) Download(token string) error {
+	shareLink, err := su.shareLinkRepository.FindOneByToken(su.db, token)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := su.shareLinkRepository.IncrementDownloadCount(su.db, shareLink); err != nil {
+		return err
+	} else if !ok {
+		return entity.ErrShareLinkExhausted
+	}
+	return nil
+}
+
+func (su *shareLinkUsecase) convertToShareLinkDTO(shareLink *entity.ShareLink) *dto.ShareLinkDTO {
+	return dto.NewShareLinkDTO(shareLink.ID, shareLink.UserID, shareLink.Token, shareLink.FileID, shareLink.FolderID, shareLink.HasPassword(), shareLink.ExpiresAt, shareLink.MaxDownloads, shareLink.DownloadCount, shareLink.CreatedAt, shareLink.UpdatedAt)
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateShareLink(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileInfo, err := entity.NewFileInfo(1, "name.txt", "/name.txt", "text/plain", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	fileInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *gorm.DB, shareLink *entity.ShareLink) (*entity.ShareLink, error) {
+		shareLink.ID = 1
+		return shareLink, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByID(gomock.Any(), fileInfo.ID).Return(fileInfo, nil)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FilePermission(gomock.Any(), entity.Principal{UserID: 1}, fileInfo).Return(entity.PermissionAdmin, nil)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	maxDownloads := uint64(3)
+	result, err := su.Create(&fileInfo.ID, nil, "secret", nil, &maxDownloads, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+
+	if result.ID != 1 || result.Token == "" || !result.HasPassword || *result.MaxDownloads != 3 {
+		t.Error("failed to create share link")
+	}
+}
+
+func TestCreateShareLinkWithoutPermission(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folderInfo.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), folderInfo.ID).Return(folderInfo, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionWrite, nil)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	if _, err := su.Create(nil, &folderInfo.ID, "", nil, nil, entity.Principal{UserID: 1}); !errors.Is(err, entity.ErrPermissionDenied) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestOpenShareLink(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderID := uint64(1)
+	shareLink, err := entity.NewShareLink(1, nil, &folderID, "secret", nil, nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	shareLink.ID = 1
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().FindOneByToken(gomock.Any(), shareLink.Token).Return(shareLink, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	result, err := su.Open(shareLink.Token, "secret")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if *result.FolderID != folderID || result.DownloadCount != 0 {
+		t.Error("failed to open share link")
+	}
+}
+
+func TestOpenShareLinkWithoutPassword(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileID := uint64(1)
+	shareLink, err := entity.NewShareLink(1, &fileID, nil, "secret", nil, nil)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().FindOneByToken(gomock.Any(), shareLink.Token).Return(shareLink, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	if _, err := su.Open(shareLink.Token, ""); !errors.Is(err, entity.ErrSharePasswordRequired) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestOpenShareLinkExpired(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileID := uint64(1)
+	expiresAt := time.Now().Add(-time.Hour)
+	shareLink := &entity.ShareLink{ID: 1, Token: "token", UserID: 1, FileID: &fileID, ExpiresAt: &expiresAt}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().FindOneByToken(gomock.Any(), "token").Return(shareLink, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	if _, err := su.Open("token", ""); !errors.Is(err, entity.ErrShareLinkExpired) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDownloadShareLink(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileID := uint64(1)
+	shareLink := &entity.ShareLink{ID: 1, Token: "token", UserID: 1, FileID: &fileID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().FindOneByToken(gomock.Any(), "token").Return(shareLink, nil)
+	shareLinkRepository.EXPECT().IncrementDownloadCount(gomock.Any(), shareLink).DoAndReturn(func(_ *gorm.DB, shareLink *entity.ShareLink) (bool, error) {
+		shareLink.DownloadCount++
+		return true, nil
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	if err := su.Download("token"); err != nil {
+		t.Error(err.Error())
+	}
+
+	if shareLink.DownloadCount != 1 {
+		t.Error("failed to count share link download")
+	}
+}
+
+func TestDownloadShareLinkExhausted(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	fileID := uint64(1)
+	shareLink := &entity.ShareLink{ID: 1, Token: "token", UserID: 1, FileID: &fileID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shareLinkRepository := mock_repository.NewMockShareLinkRepository(ctrl)
+	shareLinkRepository.EXPECT().FindOneByToken(gomock.Any(), "token").Return(shareLink, nil)
+	shareLinkRepository.EXPECT().IncrementDownloadCount(gomock.Any(), shareLink).Return(false, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	su := NewShareLinkUsecase(db, shareLinkRepository, folderInfoRepository, fileInfoRepository, userRepository, accessControlService)
+
+	if err := su.Download("token"); !errors.Is(err, entity.ErrShareLinkExhausted) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
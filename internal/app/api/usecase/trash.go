@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TrashUsecase interface {
+	FindAll(entity.Principal) ([]dto.TrashItemDTO, error)
+	Restore(uint64, string, entity.Principal) (*dto.TrashItemDTO, error)
+	Remove(uint64, entity.Principal) error
+	RemoveExpired(time.Time) error
+}
+
+type trashUsecase struct {
+	db                   *gorm.DB
+	trashItemRepository  repository.TrashItemRepository
+	folderInfoRepository repository.FolderInfoRepository
+	fileInfoRepository   repository.FileInfoRepository
+	trashService         service.TrashService
+	accessControlService service.AccessControlService
+	unitOfWork           service.UnitOfWork
+}
+
+func NewTrashUsecase(db *gorm.DB, trashItemRepository repository.TrashItemRepository, folderInfoRepository repository.FolderInfoRepository, fileInfoRepository repository.FileInfoRepository, trashService service.TrashService, accessControlService service.AccessControlService, unitOfWork service.UnitOfWork) TrashUsecase {
+	return &trashUsecase{
+		db:                   db,
+		trashItemRepository:  trashItemRepository,
+		folderInfoRepository: folderInfoRepository,
+		fileInfoRepository:   fileInfoRepository,
+		trashService:         trashService,
+		accessControlService: accessControlService,
+		unitOfWork:           unitOfWork,
+	}
+}
+
+func (tu *trashUsecase) FindAll(principal entity.Principal) ([]dto.TrashItemDTO, error) {
+	if principal.UserID == 0 {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	trashItems, err := tu.trashItemRepository.FindAllByUserID(tu.db, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.TrashItemDTO, len(trashItems))
+	for i, v := range trashItems {
+		dtos[i] = *tu.convertToTrashItemDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (tu *trashUsecase) Restore(id uint64, conflict string, principal entity.Principal) (*dto.TrashItemDTO, error) {
+	if principal.UserID == 0 {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	var trashItem *entity.TrashItem
+	if err := tu.unitOfWork.Transaction(tu.db, func(tx *gorm.DB) error {
+		var err error
+		trashItem, err = tu.find(tx, id, principal)
+		if err != nil {
+			return err
+		}
+
+		if trashItem.ParentFolderID == nil {
+			return fmt.Errorf("%w: parent folder is removed", entity.ErrRestoreConflict)
+		}
+		parentFolder, err := tu.folderInfoRepository.FindOneByID(tx, *trashItem.ParentFolderID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: parent folder is removed", entity.ErrRestoreConflict)
+		} else if err != nil {
+			return err
+		}
+
+		permission, err := tu.accessControlService.FolderPermission(tx, principal, parentFolder)
+		if err != nil {
+			return err
+		}
+		if err := authorize(permission, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		name := trashItem.Name
+		for n := 1; ; n++ {
+			if isExists, err := tu.isExists(tx, trashItem, parentFolder.Path.Value, name); err != nil {
+				return err
+			} else if !isExists {
+				break
+			}
+			if conflict != "rename" {
+				return fmt.Errorf("%w: %s is already exists", entity.ErrRestoreConflict, name)
+			}
+			name = trashItem.RenamedName(n)
+		}
+
+		path, err := tu.trashService.Restore(tx, trashItem, parentFolder, name)
+		if err != nil {
+			return err
+		}
+		trashItem.Name = name
+		trashItem.Path = path
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return tu.convertToTrashItemDTO(trashItem), nil
+}
+
+func (tu *trashUsecase) Remove(id uint64, principal entity.Principal) error {
+	if principal.UserID == 0 {
+		return entity.ErrPermissionDenied
+	}
+
+	return tu.unitOfWork.Transaction(tu.db, func(tx *gorm.DB) error {
+		trashItem, err := tu.find(tx, id, principal)
+		if err != nil {
+			return err
+		}
+
+		return tu.trashService.Purge(tx, trashItem)
+	})
+}
+
+func (tu *trashUsecase) RemoveExpired(createdAt time.Time) error {
+	trashItems, err := tu.trashItemRepository.FindAllByCreatedAtBefore(tu.db, createdAt)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range trashItems {
+		if err := tu.unitOfWork.Transaction(tu.db, func(tx *gorm.DB) error {
+			trashItem, err := tu.trashItemRepository.FindOneByID(tx, v.ID)
+			if err != nil {
+				return err
+			}
+			return tu.trashService.Purge(tx, trashItem)
+		}); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tu *trashUsecase) find(db *gorm.DB, id uint64, principal entity.Principal) (*entity.TrashItem, error) {
+	trashItem, err := tu.trashItemRepository.FindOneByID(db, id)
+	if err != nil {
+		return nil, err
+	}
+	if trashItem.UserID == nil || *trashItem.UserID != principal.UserID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return trashItem, nil
+}
+
+func (tu *trashUsecase) isExists(db *gorm.DB, trashItem *entity.TrashItem, parentPath string, name string) (bool, error) {
+	var err error
+	if trashItem.IsFolder() {
+		_, err = tu.folderInfoRepository.FindOneByPath(db, parentPath+name+"/")
+	} else {
+		_, err = tu.fileInfoRepository.FindOneByPath(db, parentPath+name)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (tu *trashUsecase) convertToTrashItemDTO(trashItem *entity.TrashItem) *dto.TrashItemDTO {
+	return dto.NewTrashItemDTO(trashItem.ID, trashItem.UserID, trashItem.FolderID, trashItem.FileID, trashItem.ParentFolderID, trashItem.Name, trashItem.Path, trashItem.CreatedAt)
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestFindAllTrashItems(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uint64(1)
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().FindAllByUserID(gomock.Any(), uint64(1)).Return([]entity.TrashItem{{ID: 1, UserID: &userID, Name: "name", Path: "/name"}}, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	tu := NewTrashUsecase(db, trashItemRepository, folderInfoRepository, fileInfoRepository, trashService, accessControlService, unitOfWork)
+
+	result, err := tu.FindAll(entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 || result[0].Path != "/name" {
+		t.Error("failed to find trash items")
+	}
+}
+
+func TestRestoreTrashItemWithRename(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	userID := uint64(1)
+	parentFolderID := uint64(1)
+	fileID := uint64(2)
+	trashItem := &entity.TrashItem{ID: 3, UserID: &userID, FileID: &fileID, ParentFolderID: &parentFolderID, Name: "name.txt", Path: "/name.txt"}
+
+	parentFolder, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	parentFolder.ID = parentFolderID
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().FindOneByID(gomock.Any(), trashItem.ID).Return(trashItem, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), parentFolderID).Return(parentFolder, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	fileInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/name.txt").Return(&entity.FileInfo{}, nil)
+	fileInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/name (1).txt").Return(nil, gorm.ErrRecordNotFound)
+
+	trashService := mock_service.NewMockTrashService(ctrl)
+	trashService.EXPECT().Restore(gomock.Any(), trashItem, parentFolder, "name (1).txt").Return("/name (1).txt", nil)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, parentFolder).Return(entity.PermissionWrite, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	tu := NewTrashUsecase(db, trashItemRepository, folderInfoRepository, fileInfoRepository, trashService, accessControlService, unitOfWork)
+
+	result, err := tu.Restore(trashItem.ID, "rename", entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.Path != "/name (1).txt" {
+		t.Error("failed to restore trash item")
+	}
+}
+
+func TestRestoreTrashItemConflict(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	userID := uint64(1)
+	parentFolderID := uint64(1)
+	folderID := uint64(2)
+	trashItem := &entity.TrashItem{ID: 3, UserID: &userID, FolderID: &folderID, ParentFolderID: &parentFolderID, Name: "name", Path: "/name/"}
+
+	parentFolder, err := entity.NewFolderInfo(nil, "root", "/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	parentFolder.ID = parentFolderID
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().FindOneByID(gomock.Any(), trashItem.ID).Return(trashItem, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), parentFolderID).Return(parentFolder, nil)
+	folderInfoRepository.EXPECT().FindOneByPath(gomock.Any(), "/name/").Return(&entity.FolderInfo{}, nil)
+
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	trashService := mock_service.NewMockTrashService(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, parentFolder).Return(entity.PermissionWrite, nil)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	tu := NewTrashUsecase(db, trashItemRepository, folderInfoRepository, fileInfoRepository, trashService, accessControlService, unitOfWork)
+
+	if _, err := tu.Restore(trashItem.ID, "fail", entity.Principal{UserID: 1}); !errors.Is(err, entity.ErrRestoreConflict) {
+		t.Error("trash item is restored over an existing folder")
+	}
+}
+
+func TestRemoveTrashItemOfOtherUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	userID := uint64(1)
+	fileID := uint64(2)
+	trashItem := &entity.TrashItem{ID: 3, UserID: &userID, FileID: &fileID, Name: "name", Path: "/name"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashItemRepository := mock_repository.NewMockTrashItemRepository(ctrl)
+	trashItemRepository.EXPECT().FindOneByID(gomock.Any(), trashItem.ID).Return(trashItem, nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	fileInfoRepository := mock_repository.NewMockFileInfoRepository(ctrl)
+	trashService := mock_service.NewMockTrashService(ctrl)
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	unitOfWork := mock_service.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(db *gorm.DB, fn func(*gorm.DB) error) error {
+		return db.Transaction(fn)
+	}).AnyTimes()
+
+	tu := NewTrashUsecase(db, trashItemRepository, folderInfoRepository, fileInfoRepository, trashService, accessControlService, unitOfWork)
+
+	if err := tu.Remove(trashItem.ID, entity.Principal{UserID: 2}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Error("trash item of other user is removed")
+	}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"file-server/internal/pkg/types"
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UploadSessionUsecase interface {
+	Create(uint64, string, int64, bool, entity.Principal) (*dto.UploadSessionDTO, error)
+	FindOne(string, entity.Principal) (*dto.UploadSessionDTO, error)
+	Append(string, int64, io.Reader, entity.Principal) (*dto.UploadSessionDTO, error)
+	Complete(string, entity.Principal) (*dto.FileInfoDTO, error)
+	Remove(string, entity.Principal) error
+	RemoveExpired(time.Time) error
+}
+
+type uploadSessionUsecase struct {
+	db                      *gorm.DB
+	uploadSessionRepository repository.UploadSessionRepository
+	uploadBodyRepository    repository.UploadBodyRepository
+	folderInfoRepository    repository.FolderInfoRepository
+	fileUsecase             FileUsecase
+	accessControlService    service.AccessControlService
+}
+
+func NewUploadSessionUsecase(db *gorm.DB, uploadSessionRepository repository.UploadSessionRepository, uploadBodyRepository repository.UploadBodyRepository, folderInfoRepository repository.FolderInfoRepository, fileUsecase FileUsecase, accessControlService service.AccessControlService) UploadSessionUsecase {
+	return &uploadSessionUsecase{
+		db:                      db,
+		uploadSessionRepository: uploadSessionRepository,
+		uploadBodyRepository:    uploadBodyRepository,
+		folderInfoRepository:    folderInfoRepository,
+		fileUsecase:             fileUsecase,
+		accessControlService:    accessControlService,
+	}
+}
+
+func (uu *uploadSessionUsecase) Create(folderID uint64, name string, size int64, isHide bool, principal entity.Principal) (*dto.UploadSessionDTO, error) {
+	var uploadSession *entity.UploadSession
+	if err := uu.db.Transaction(func(tx *gorm.DB) error {
+		folderInfo, err := uu.folderInfoRepository.FindOneByID(tx, folderID)
+		if err != nil {
+			return err
+		}
+
+		permission, err := uu.accessControlService.FolderPermission(tx, principal, folderInfo)
+		if err != nil {
+			return err
+		}
+		if err := authorize(permission, entity.PermissionWrite); err != nil {
+			return err
+		}
+
+		uploadSession, err = entity.NewUploadSession(principal.UserID, folderID, name, size, isHide)
+		if err != nil {
+			return err
+		}
+
+		uploadSession, err = uu.uploadSessionRepository.Create(tx, uploadSession)
+		if err != nil {
+			return err
+		}
+
+		return uu.uploadBodyRepository.Create(uploadSession.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	return uu.convertToUploadSessionDTO(uploadSession), nil
+}
+
+func (uu *uploadSessionUsecase) FindOne(id string, principal entity.Principal) (*dto.UploadSessionDTO, error) {
+	uploadSession, err := uu.findOne(id, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	return uu.convertToUploadSessionDTO(uploadSession), nil
+}
+
+func (uu *uploadSessionUsecase) Append(id string, offset int64, body io.Reader, principal entity.Principal) (*dto.UploadSessionDTO, error) {
+	var uploadSession *entity.UploadSession
+	var writeErr error
+	if err := uu.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		uploadSession, err = uu.uploadSessionRepository.FindOneByIDWithLock(tx, id)
+		if err != nil {
+			return err
+		}
+		if uploadSession.UserID != principal.UserID {
+			return gorm.ErrRecordNotFound
+		}
+
+		if offset != uploadSession.Offset {
+			return entity.ErrUploadOffsetMismatch
+		}
+
+		var n int64
+		n, writeErr = uu.uploadBodyRepository.Write(uploadSession.ID, offset, io.LimitReader(body, uploadSession.Remaining()))
+		if writeErr != nil && n == 0 {
+			return writeErr
+		}
+
+		if err := uploadSession.Append(offset, n); err != nil {
+			return err
+		}
+
+		uploadSession, err = uu.uploadSessionRepository.Update(tx, uploadSession)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if writeErr != nil {
+		return nil, writeErr
+	}
+
+	return uu.convertToUploadSessionDTO(uploadSession), nil
+}
+
+func (uu *uploadSessionUsecase) Complete(id string, principal entity.Principal) (*dto.FileInfoDTO, error) {
+	uploadSession, err := uu.findOne(id, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uploadSession.IsCompleted() {
+		return nil, entity.ErrUploadIncomplete
+	}
+
+	dtos, err := func() ([]dto.FileInfoDTO, error) {
+		body, err := uu.uploadBodyRepository.Read(uploadSession.ID)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return uu.fileUsecase.Create(uploadSession.FolderID, uploadSession.IsHide, []types.File{{Name: uploadSession.Name.Value, Body: body}}, entity.ConflictFail, principal)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uu.remove(uploadSession); err != nil {
+		return nil, err
+	}
+
+	return &dtos[0], nil
+}
+
+func (uu *uploadSessionUsecase) Remove(id string, principal entity.Principal) error {
+	uploadSession, err := uu.findOne(id, principal)
+	if err != nil {
+		return err
+	}
+
+	return uu.remove(uploadSession)
+}
+
+func (uu *uploadSessionUsecase) RemoveExpired(updatedAt time.Time) error {
+	uploadSessions, err := uu.uploadSessionRepository.FindByUpdatedAtBefore(uu.db, updatedAt)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range uploadSessions {
+		if err := uu.remove(&v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uu *uploadSessionUsecase) findOne(id string, principal entity.Principal) (*entity.UploadSession, error) {
+	uploadSession, err := uu.uploadSessionRepository.FindOneByID(uu.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if uploadSession.UserID != principal.UserID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return uploadSession, nil
+}
+
+func (uu *uploadSessionUsecase) remove(uploadSession *entity.UploadSession) error {
+	return uu.db.Transaction(func(tx *gorm.DB) error {
+		if err := uu.uploadSessionRepository.Remove(tx, uploadSession); err != nil {
+			return err
+		}
+
+		return uu.uploadBodyRepository.Remove(uploadSession.ID)
+	})
+}
+
+func (uu *uploadSessionUsecase) convertToUploadSessionDTO(uploadSession *entity.UploadSession) *dto.UploadSessionDTO {
+	return dto.NewUploadSessionDTO(uploadSession.ID, uploadSession.FolderID, uploadSession.Name.Value, uploadSession.Size, uploadSession.Offset, uploadSession.IsHide, uploadSession.CreatedAt, uploadSession.UpdatedAt)
+}
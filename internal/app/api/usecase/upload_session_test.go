@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"errors"
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/usecase/dto"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	mock_usecase "file-server/test/mock/usecase"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCreateUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	folderInfo, err := entity.NewFolderInfo(nil, "name", "/path/name/", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(uploadSession, nil)
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+	uploadBodyRepository.EXPECT().Create(uploadSession.ID).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+	folderInfoRepository.EXPECT().FindOneByID(gomock.Any(), gomock.Any()).Return(folderInfo, nil)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	accessControlService.EXPECT().FolderPermission(gomock.Any(), entity.Principal{UserID: 1}, folderInfo).Return(entity.PermissionWrite, nil)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	result, err := uu.Create(uploadSession.FolderID, uploadSession.Name.Value, uploadSession.Size, uploadSession.IsHide, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil {
+		t.Error("failed to create upload session")
+	}
+}
+
+func TestAppendUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindOneByIDWithLock(gomock.Any(), uploadSession.ID).Return(uploadSession, nil)
+	uploadSessionRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, u *entity.UploadSession) (*entity.UploadSession, error) {
+		return u, nil
+	})
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+	uploadBodyRepository.EXPECT().Write(uploadSession.ID, int64(0), gomock.Any()).DoAndReturn(func(_ string, _ int64, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	})
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	result, err := uu.Append(uploadSession.ID, 0, strings.NewReader("file!"), entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.Offset != 4 {
+		t.Error("failed to append upload session")
+	}
+}
+
+func TestAppendUploadSessionWithInterruptedBody(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindOneByIDWithLock(gomock.Any(), uploadSession.ID).Return(uploadSession, nil)
+	uploadSessionRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, u *entity.UploadSession) (*entity.UploadSession, error) {
+		if u.Offset != 2 {
+			t.Errorf("failed to save the written offset: %d", u.Offset)
+		}
+		return u, nil
+	})
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+	uploadBodyRepository.EXPECT().Write(uploadSession.ID, int64(0), gomock.Any()).Return(int64(2), io.ErrUnexpectedEOF)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	if _, err := uu.Append(uploadSession.ID, 0, strings.NewReader("fi"), entity.Principal{UserID: 1}); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Error("failed to return the write error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestAppendUploadSessionWithOffsetMismatch(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindOneByIDWithLock(gomock.Any(), uploadSession.ID).Return(uploadSession, nil)
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	if _, err := uu.Append(uploadSession.ID, 2, strings.NewReader("le"), entity.Principal{UserID: 1}); err != entity.ErrUploadOffsetMismatch {
+		t.Error("failed to detect offset mismatch")
+	}
+}
+
+func TestCompleteUploadSession(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	uploadSession.Offset = 4
+
+	dtos := []dto.FileInfoDTO{*dto.NewFileInfoDTO(1, 1, nil, "name", "/name", "text/plain", 4, false, time.Now(), time.Now())}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindOneByID(gomock.Any(), uploadSession.ID).Return(uploadSession, nil)
+	uploadSessionRepository.EXPECT().Remove(gomock.Any(), uploadSession).Return(nil)
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+	uploadBodyRepository.EXPECT().Read(uploadSession.ID).Return(io.NopCloser(strings.NewReader("file")), nil)
+	uploadBodyRepository.EXPECT().Remove(uploadSession.ID).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+	fileUsecase.EXPECT().Create(uploadSession.FolderID, uploadSession.IsHide, gomock.Any(), entity.ConflictFail, entity.Principal{UserID: uploadSession.UserID}).Return(dtos, nil)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	result, err := uu.Complete(uploadSession.ID, entity.Principal{UserID: 1})
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil {
+		t.Error("failed to complete upload session")
+	}
+}
+
+func TestCompleteUploadSessionByOtherUser(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindOneByID(gomock.Any(), uploadSession.ID).Return(uploadSession, nil)
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	if _, err := uu.Complete(uploadSession.ID, entity.Principal{UserID: 2}); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Error("upload session is completed by other user")
+	}
+}
+
+func TestRemoveExpiredUploadSessions(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	uploadSession, err := entity.NewUploadSession(1, 1, "name", 4, false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uploadSessionRepository := mock_repository.NewMockUploadSessionRepository(ctrl)
+	uploadSessionRepository.EXPECT().FindByUpdatedAtBefore(gomock.Any(), gomock.Any()).Return([]entity.UploadSession{*uploadSession}, nil)
+	uploadSessionRepository.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+
+	uploadBodyRepository := mock_repository.NewMockUploadBodyRepository(ctrl)
+	uploadBodyRepository.EXPECT().Remove(uploadSession.ID).Return(nil)
+
+	folderInfoRepository := mock_repository.NewMockFolderInfoRepository(ctrl)
+
+	fileUsecase := mock_usecase.NewMockFileUsecase(ctrl)
+
+	accessControlService := mock_service.NewMockAccessControlService(ctrl)
+
+	uu := NewUploadSessionUsecase(db, uploadSessionRepository, uploadBodyRepository, folderInfoRepository, fileUsecase, accessControlService)
+
+	if err := uu.RemoveExpired(time.Now()); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/internal/app/api/domain/repository"
+	"file-server/internal/app/api/domain/service"
+	"file-server/internal/app/api/usecase/dto"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type UserUsecase interface {
+	Create(string, string, bool) (*dto.UserDTO, error)
+	FindAll() ([]dto.UserDTO, error)
+	FindOneByName(string) (*dto.UserDTO, error)
+	SetPassword(uint64, string) (*dto.UserDTO, error)
+	Disable(uint64) (*dto.UserDTO, error)
+	Enable(uint64) (*dto.UserDTO, error)
+	Remove(uint64) error
+}
+
+type userUsecase struct {
+	db             *gorm.DB
+	userRepository repository.UserRepository
+	userService    service.UserService
+}
+
+func NewUserUsecase(db *gorm.DB, userRepository repository.UserRepository, userService service.UserService) UserUsecase {
+	return &userUsecase{
+		db:             db,
+		userRepository: userRepository,
+		userService:    userService,
+	}
+}
+
+func (uu *userUsecase) Create(name string, password string, isAdmin bool) (*dto.UserDTO, error) {
+	var user *entity.User
+	if err := uu.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = entity.NewUser(name, password, isAdmin)
+		if err != nil {
+			return err
+		}
+
+		if isExists, err := uu.userService.IsExists(tx, user); err != nil {
+			return err
+		} else if isExists {
+			return entity.NewError(entity.ErrConflict, "user_already_exists", fmt.Sprintf("%s is already exists", user.Name.Value))
+		}
+
+		user, err = uu.userRepository.Create(tx, user)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return convertToUserDTO(user), nil
+}
+
+func (uu *userUsecase) FindAll() ([]dto.UserDTO, error) {
+	users, err := uu.userRepository.FindAll(uu.db)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]dto.UserDTO, len(users))
+	for i, v := range users {
+		dtos[i] = *convertToUserDTO(&v)
+	}
+	return dtos, nil
+}
+
+func (uu *userUsecase) FindOneByName(name string) (*dto.UserDTO, error) {
+	user, err := uu.userRepository.FindOneByName(uu.db, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToUserDTO(user), nil
+}
+
+func (uu *userUsecase) SetPassword(id uint64, password string) (*dto.UserDTO, error) {
+	var user *entity.User
+	if err := uu.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = uu.userRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := user.SetPassword(password); err != nil {
+			return err
+		}
+		user.RevokeTokens()
+
+		user, err = uu.userRepository.Update(tx, user)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return convertToUserDTO(user), nil
+}
+
+func (uu *userUsecase) Disable(id uint64) (*dto.UserDTO, error) {
+	return uu.setIsDisabled(id, true)
+}
+
+func (uu *userUsecase) Enable(id uint64) (*dto.UserDTO, error) {
+	return uu.setIsDisabled(id, false)
+}
+
+func (uu *userUsecase) Remove(id uint64) error {
+	return uu.db.Transaction(func(tx *gorm.DB) error {
+		user, err := uu.userRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		return uu.userRepository.Remove(tx, user)
+	})
+}
+
+func (uu *userUsecase) setIsDisabled(id uint64, isDisabled bool) (*dto.UserDTO, error) {
+	var user *entity.User
+	if err := uu.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = uu.userRepository.FindOneByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		user.IsDisabled = isDisabled
+		if isDisabled {
+			user.RevokeTokens()
+		}
+
+		user, err = uu.userRepository.Update(tx, user)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return convertToUserDTO(user), nil
+}
+
+func convertToUserDTO(user *entity.User) *dto.UserDTO {
+	return dto.NewUserDTO(user.ID, user.Name.Value, user.IsAdmin, user.IsDisabled, user.CreatedAt, user.UpdatedAt)
+}
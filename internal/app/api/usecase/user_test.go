@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"file-server/internal/app/api/domain/entity"
+	"file-server/test/database"
+	mock_repository "file-server/test/mock/domain/repository"
+	mock_service "file-server/test/mock/domain/service"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCreateUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(user, nil)
+
+	userService := mock_service.NewMockUserService(ctrl)
+	userService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	result, err := uu.Create("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if result == nil || result.Name != "name" {
+		t.Error("failed to create user")
+	}
+}
+
+func TestCreateUserAlreadyExists(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+
+	userService := mock_service.NewMockUserService(ctrl)
+	userService.EXPECT().IsExists(gomock.Any(), gomock.Any()).Return(true, nil)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	if _, err := uu.Create("name", "password", false); err == nil {
+		t.Error("duplicated user is created")
+	}
+}
+
+func TestFindAllUser(t *testing.T) {
+	db, _, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindAll(gomock.Any()).Return([]entity.User{*user}, nil)
+
+	userService := mock_service.NewMockUserService(ctrl)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	result, err := uu.FindAll()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 1 {
+		t.Error("failed to find users")
+	}
+}
+
+func TestDisableUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, user *entity.User) (*entity.User, error) {
+		return user, nil
+	})
+
+	userService := mock_service.NewMockUserService(ctrl)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	result, err := uu.Disable(user.ID)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if !result.IsDisabled {
+		t.Error("failed to disable user")
+	}
+	if user.TokensValidAfter == nil {
+		t.Error("failed to revoke issued tokens")
+	}
+}
+
+func TestSetUserPassword(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, user *entity.User) (*entity.User, error) {
+		if err := user.Authenticate("new-password"); err != nil {
+			t.Error("failed to set password")
+		}
+		if user.TokensValidAfter == nil {
+			t.Error("failed to revoke issued tokens")
+		}
+		return user, nil
+	})
+
+	userService := mock_service.NewMockUserService(ctrl)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	if _, err := uu.SetPassword(user.ID, "new-password"); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestSetUserPasswordTooShort(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+
+	userService := mock_service.NewMockUserService(ctrl)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	if _, err := uu.SetPassword(user.ID, "short"); err == nil {
+		t.Error("short password is set")
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	user, err := entity.NewUser("name", "password", false)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	user.ID = 1
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().FindOneByID(gomock.Any(), user.ID).Return(user, nil)
+	userRepository.EXPECT().Remove(gomock.Any(), user).Return(nil)
+
+	userService := mock_service.NewMockUserService(ctrl)
+
+	uu := NewUserUsecase(db, userRepository, userService)
+
+	if err := uu.Remove(user.ID); err != nil {
+		t.Error(err.Error())
+	}
+}
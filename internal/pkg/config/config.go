@@ -4,16 +4,36 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-)
-
-const (
-	STORAGE_PATH = "storage"
+	"strings"
+	"time"
 )
 
 var (
-	API_PORT       int
-	MYSQL_DSN      string
-	JWT_SECRET_KEY string
+	API_PORT                  int
+	MYSQL_DSN                 string
+	JWT_SECRET_KEY            string
+	AUTH_POLICY               string
+	ACCESS_TOKEN_EXPIRATION   time.Duration
+	REFRESH_TOKEN_EXPIRATION  time.Duration
+	STORAGE_PATH              string
+	UPLOAD_PATH               string
+	STORAGE_FILE_MODE         os.FileMode
+	STORAGE_DIR_MODE          os.FileMode
+	STORAGE_QUOTA             int64
+	UPLOAD_SESSION_EXPIRATION time.Duration
+	TRASH_RETENTION           time.Duration
+	FILE_VERSION_LIMIT        int
+	EXTRACT_MAX_ENTRIES       int
+	EXTRACT_MAX_SIZE          int64
+	STORAGE_DRIVER            string
+	S3_ENDPOINT               string
+	S3_REGION                 string
+	S3_BUCKET                 string
+	S3_ACCESS_KEY             string
+	S3_SECRET_KEY             string
+	S3_USE_SSL                bool
+	S3_PART_SIZE              uint64
+	S3_PRESIGN_EXPIRATION     time.Duration
 )
 
 func Load() error {
@@ -30,5 +50,159 @@ func Load() error {
 
 	JWT_SECRET_KEY = os.Getenv("JWT_SECRET_KEY")
 
+	ACCESS_TOKEN_EXPIRATION = time.Hour
+	if v := os.Getenv("ACCESS_TOKEN_EXPIRATION"); v != "" {
+		if ACCESS_TOKEN_EXPIRATION, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+	}
+
+	REFRESH_TOKEN_EXPIRATION = 30 * 24 * time.Hour
+	if v := os.Getenv("REFRESH_TOKEN_EXPIRATION"); v != "" {
+		if REFRESH_TOKEN_EXPIRATION, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+	}
+	if ACCESS_TOKEN_EXPIRATION <= 0 || REFRESH_TOKEN_EXPIRATION < ACCESS_TOKEN_EXPIRATION {
+		return fmt.Errorf("invalid token expiration")
+	}
+
+	AUTH_POLICY = "public"
+	if v := os.Getenv("AUTH_POLICY"); v != "" {
+		AUTH_POLICY = v
+	}
+
+	STORAGE_PATH = "storage"
+	if v := os.Getenv("STORAGE_PATH"); v != "" {
+		STORAGE_PATH = strings.TrimSuffix(v, "/")
+	}
+
+	UPLOAD_PATH = "uploads"
+	if v := os.Getenv("UPLOAD_PATH"); v != "" {
+		UPLOAD_PATH = strings.TrimSuffix(v, "/")
+	}
+
+	if STORAGE_FILE_MODE, err = loadFileMode("STORAGE_FILE_MODE", 0644); err != nil {
+		return err
+	}
+	if STORAGE_DIR_MODE, err = loadFileMode("STORAGE_DIR_MODE", 0755); err != nil {
+		return err
+	}
+
+	STORAGE_QUOTA = 0
+	if v := os.Getenv("STORAGE_QUOTA"); v != "" {
+		if STORAGE_QUOTA, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return err
+		}
+	}
+	if STORAGE_QUOTA < 0 {
+		return fmt.Errorf("invalid storage quota: %d", STORAGE_QUOTA)
+	}
+
+	UPLOAD_SESSION_EXPIRATION = 24 * time.Hour
+	if v := os.Getenv("UPLOAD_SESSION_EXPIRATION"); v != "" {
+		if UPLOAD_SESSION_EXPIRATION, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+	}
+
+	TRASH_RETENTION = 30 * 24 * time.Hour
+	if v := os.Getenv("TRASH_RETENTION"); v != "" {
+		if TRASH_RETENTION, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+	}
+	if TRASH_RETENTION <= 0 {
+		return fmt.Errorf("invalid trash retention: %s", TRASH_RETENTION)
+	}
+
+	FILE_VERSION_LIMIT = 10
+	if v := os.Getenv("FILE_VERSION_LIMIT"); v != "" {
+		if FILE_VERSION_LIMIT, err = strconv.Atoi(v); err != nil {
+			return err
+		}
+	}
+	if FILE_VERSION_LIMIT < 0 {
+		return fmt.Errorf("invalid file version limit: %d", FILE_VERSION_LIMIT)
+	}
+
+	EXTRACT_MAX_ENTRIES = 10000
+	if v := os.Getenv("EXTRACT_MAX_ENTRIES"); v != "" {
+		if EXTRACT_MAX_ENTRIES, err = strconv.Atoi(v); err != nil {
+			return err
+		}
+	}
+	if EXTRACT_MAX_ENTRIES < 0 {
+		return fmt.Errorf("invalid extract max entries: %d", EXTRACT_MAX_ENTRIES)
+	}
+
+	EXTRACT_MAX_SIZE = 1 << 30
+	if v := os.Getenv("EXTRACT_MAX_SIZE"); v != "" {
+		if EXTRACT_MAX_SIZE, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return err
+		}
+	}
+	if EXTRACT_MAX_SIZE < 0 {
+		return fmt.Errorf("invalid extract max size: %d", EXTRACT_MAX_SIZE)
+	}
+
+	STORAGE_DRIVER = "local"
+	if v := os.Getenv("STORAGE_DRIVER"); v != "" {
+		STORAGE_DRIVER = v
+	}
+	if STORAGE_DRIVER != "local" && STORAGE_DRIVER != "blob" && STORAGE_DRIVER != "s3" {
+		return fmt.Errorf("invalid storage driver: %s", STORAGE_DRIVER)
+	}
+
+	S3_ENDPOINT = os.Getenv("S3_ENDPOINT")
+	S3_REGION = "us-east-1"
+	if v := os.Getenv("S3_REGION"); v != "" {
+		S3_REGION = v
+	}
+	S3_BUCKET = os.Getenv("S3_BUCKET")
+	S3_ACCESS_KEY = os.Getenv("S3_ACCESS_KEY")
+	S3_SECRET_KEY = os.Getenv("S3_SECRET_KEY")
+
+	S3_USE_SSL = false
+	if v := os.Getenv("S3_USE_SSL"); v != "" {
+		if S3_USE_SSL, err = strconv.ParseBool(v); err != nil {
+			return err
+		}
+	}
+
+	S3_PART_SIZE = 16 << 20
+	if v := os.Getenv("S3_PART_SIZE"); v != "" {
+		if S3_PART_SIZE, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return err
+		}
+	}
+
+	S3_PRESIGN_EXPIRATION = 15 * time.Minute
+	if v := os.Getenv("S3_PRESIGN_EXPIRATION"); v != "" {
+		if S3_PRESIGN_EXPIRATION, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+	}
+
+	if STORAGE_DRIVER == "s3" && (S3_ENDPOINT == "" || S3_BUCKET == "") {
+		return fmt.Errorf("s3 endpoint and bucket are required")
+	}
+
 	return nil
 }
+
+func loadFileMode(key string, defaultMode os.FileMode) (os.FileMode, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultMode, nil
+	}
+
+	mode, err := strconv.ParseUint(v, 8, 32)
+	if err != nil {
+		return 0, err
+	}
+	if mode&^uint64(os.ModePerm) != 0 {
+		return 0, fmt.Errorf("invalid file mode: %s", v)
+	}
+	return os.FileMode(mode), nil
+}
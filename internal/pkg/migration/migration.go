@@ -0,0 +1,216 @@
+package migration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDirty    = fmt.Errorf("database is dirty")
+	ErrNoChange = fmt.Errorf("no change")
+)
+
+// nilVersion is the version golang-migrate records while the first migration
+// is applied or the last one is rolled back. It is read back as version 0.
+const nilVersion = -1
+
+var fileNamePattern = regexp.MustCompile(`^([0-9]+)_(.+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+func Load(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := map[uint64]*Migration{}
+	for _, v := range entries {
+		matches := fileNamePattern.FindStringSubmatch(v.Name())
+		if v.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if version == 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", v.Name())
+		}
+
+		body, err := os.ReadFile(filepath.Join(dir, v.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			migrations[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version: %d", version)
+		}
+		if matches[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+
+	result := make([]Migration, 0, len(migrations))
+	for _, v := range migrations {
+		result = append(result, *v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+type schemaMigration struct {
+	Version int64
+	Dirty   bool
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *gorm.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+func (m *Migrator) Version() (uint64, bool, error) {
+	if err := m.db.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version bigint not null primary key, dirty boolean not null)").Error; err != nil {
+		return 0, false, err
+	}
+
+	var records []schemaMigration
+	if err := m.db.Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&records).Error; err != nil {
+		return 0, false, err
+	}
+	if len(records) == 0 {
+		return 0, false, nil
+	}
+	if records[0].Version < 0 {
+		return 0, records[0].Dirty, nil
+	}
+	return uint64(records[0].Version), records[0].Dirty, nil
+}
+
+func (m *Migrator) Up(limit int) error {
+	version, err := m.clean()
+	if err != nil {
+		return err
+	}
+
+	var pending []Migration
+	for _, v := range m.migrations {
+		if version < v.Version {
+			pending = append(pending, v)
+		}
+	}
+	if 0 < limit && limit < len(pending) {
+		pending = pending[:limit]
+	}
+	if len(pending) == 0 {
+		return ErrNoChange
+	}
+
+	for _, v := range pending {
+		if err := m.run(v.Version, v.Up); err != nil {
+			return fmt.Errorf("%d_%s: %w", v.Version, v.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) Down(limit int) error {
+	version, err := m.clean()
+	if err != nil {
+		return err
+	}
+
+	var applied []Migration
+	for _, v := range m.migrations {
+		if v.Version <= version {
+			applied = append(applied, v)
+		}
+	}
+	if len(applied) == 0 {
+		return ErrNoChange
+	}
+
+	if limit <= 0 || len(applied) < limit {
+		limit = len(applied)
+	}
+	for i := len(applied) - 1; len(applied)-limit <= i; i-- {
+		var previous uint64
+		if 0 < i {
+			previous = applied[i-1].Version
+		}
+		if err := m.run(previous, applied[i].Down); err != nil {
+			return fmt.Errorf("%d_%s: %w", applied[i].Version, applied[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) Force(version uint64) error {
+	if _, _, err := m.Version(); err != nil {
+		return err
+	}
+	return m.setVersion(version, false)
+}
+
+func (m *Migrator) clean() (uint64, error) {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w: version %d", ErrDirty, version)
+	}
+	return version, nil
+}
+
+func (m *Migrator) run(version uint64, query string) error {
+	if err := m.setVersion(version, true); err != nil {
+		return err
+	}
+	if query != "" {
+		if err := m.db.Exec(query).Error; err != nil {
+			return err
+		}
+	}
+	return m.setVersion(version, false)
+}
+
+func (m *Migrator) setVersion(version uint64, dirty bool) error {
+	if err := m.db.Exec("TRUNCATE schema_migrations").Error; err != nil {
+		return err
+	}
+	if version == 0 {
+		if !dirty {
+			return nil
+		}
+		return m.db.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)", nilVersion, dirty).Error
+	}
+	return m.db.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)", version, dirty).Error
+}
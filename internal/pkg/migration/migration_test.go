@@ -0,0 +1,241 @@
+package migration
+
+import (
+	"errors"
+	"file-server/test/database"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"000002_b.up.sql":   "UP 2",
+		"000002_b.down.sql": "DOWN 2",
+		"000001_a.up.sql":   "UP 1",
+		"000001_a.down.sql": "DOWN 1",
+		"README.md":         "ignored",
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0644); err != nil {
+			t.Error(err.Error())
+		}
+	}
+
+	result, err := Load(dir)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if len(result) != 2 || result[0].Version != 1 || result[0].Up != "UP 1" || result[1].Name != "b" || result[1].Down != "DOWN 2" {
+		t.Error("failed to load migrations")
+	}
+}
+
+func TestUp(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(1, false))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(2, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UP 2")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(2, false).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	m := NewMigrator(db, []Migration{
+		{Version: 1, Name: "a", Up: "UP 1", Down: "DOWN 1"},
+		{Version: 2, Name: "b", Up: "UP 2", Down: "DOWN 2"},
+	})
+
+	if err := m.Up(0); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestUpDirty(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(1, true))
+
+	m := NewMigrator(db, []Migration{{Version: 2, Name: "b", Up: "UP 2"}})
+
+	if err := m.Up(0); !errors.Is(err, ErrDirty) {
+		t.Error("dirty database is migrated")
+	}
+}
+
+func TestDown(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(2, false))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(1, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DOWN 2")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(1, false).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	m := NewMigrator(db, []Migration{
+		{Version: 1, Name: "a", Up: "UP 1", Down: "DOWN 1"},
+		{Version: 2, Name: "b", Up: "UP 2", Down: "DOWN 2"},
+	})
+
+	if err := m.Down(1); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestVersionMigratedByMigrateCLI(t *testing.T) {
+	for _, v := range []struct {
+		version int64
+		dirty   bool
+		result  uint64
+	}{
+		{19, false, 19},
+		{19, true, 19},
+		{-1, true, 0},
+	} {
+		db, mock, err := database.Open()
+		if err != nil {
+			t.Error(err.Error())
+		}
+
+		// golang-migrate creates the same table and keeps a single signed version row
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations (version bigint not null primary key, dirty boolean not null)")).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(v.version, v.dirty))
+
+		version, dirty, err := NewMigrator(db, nil).Version()
+		if err != nil {
+			t.Error(err.Error())
+		}
+		if version != v.result || dirty != v.dirty {
+			t.Errorf("version %d (dirty %t): got %d (dirty %t)", v.version, v.dirty, version, dirty)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err.Error())
+		}
+	}
+}
+
+func TestUpMigratedByMigrateCLI(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(int64(2), false))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(3, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UP 3")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(3, false).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	m := NewMigrator(db, []Migration{
+		{Version: 1, Name: "a", Up: "UP 1", Down: "DOWN 1"},
+		{Version: 2, Name: "b", Up: "UP 2", Down: "DOWN 2"},
+		{Version: 3, Name: "c", Up: "UP 3", Down: "DOWN 3"},
+	})
+
+	if err := m.Up(0); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestUpDirtyNilVersion(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(int64(-1), true))
+
+	m := NewMigrator(db, []Migration{{Version: 1, Name: "a", Up: "UP 1"}})
+
+	if err := m.Up(0); !errors.Is(err, ErrDirty) {
+		t.Error("dirty database is migrated")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestDownToNilVersion(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	// golang-migrate records -1 while rolling back the first migration and no row afterwards
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(int64(1), false))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(-1, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DOWN 1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m := NewMigrator(db, []Migration{{Version: 1, Name: "a", Up: "UP 1", Down: "DOWN 1"}})
+
+	if err := m.Down(0); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestForceDirty(t *testing.T) {
+	db, mock, err := database.Open()
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(int64(2), true))
+	mock.ExpectExec(regexp.QuoteMeta("TRUNCATE schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)")).WithArgs(1, false).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	m := NewMigrator(db, []Migration{
+		{Version: 1, Name: "a", Up: "UP 1", Down: "DOWN 1"},
+		{Version: 2, Name: "b", Up: "UP 2", Down: "DOWN 2"},
+	})
+
+	if err := m.Force(1); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
+import "io"
+
 type File struct {
 	Name string
-	Body []byte
+	Body io.Reader
 }
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"os"
+	"time"
+)
+
+type Storage struct {
+	Path     string
+	FileMode os.FileMode
+	DirMode  os.FileMode
+	Quota    int64
+}
+
+type Bucket struct {
+	Endpoint          string
+	Region            string
+	Name              string
+	AccessKey         string
+	SecretKey         string
+	UseSSL            bool
+	PartSize          uint64
+	PresignExpiration time.Duration
+	Quota             int64
+}
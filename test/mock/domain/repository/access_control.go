@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/access_control.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAccessControlRepository is a mock of AccessControlRepository interface.
+type MockAccessControlRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessControlRepositoryMockRecorder
+}
+
+// MockAccessControlRepositoryMockRecorder is the mock recorder for MockAccessControlRepository.
+type MockAccessControlRepositoryMockRecorder struct {
+	mock *MockAccessControlRepository
+}
+
+// NewMockAccessControlRepository creates a new mock instance.
+func NewMockAccessControlRepository(ctrl *gomock.Controller) *MockAccessControlRepository {
+	mock := &MockAccessControlRepository{ctrl: ctrl}
+	mock.recorder = &MockAccessControlRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessControlRepository) EXPECT() *MockAccessControlRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAccessControlRepository) Create(arg0 *gorm.DB, arg1 *entity.AccessControlEntry) (*entity.AccessControlEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.AccessControlEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccessControlRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessControlRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAllByFolderID mocks base method.
+func (m *MockAccessControlRepository) FindAllByFolderID(arg0 *gorm.DB, arg1 uint64) ([]entity.AccessControlEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByFolderID", arg0, arg1)
+	ret0, _ := ret[0].([]entity.AccessControlEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByFolderID indicates an expected call of FindAllByFolderID.
+func (mr *MockAccessControlRepositoryMockRecorder) FindAllByFolderID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByFolderID", reflect.TypeOf((*MockAccessControlRepository)(nil).FindAllByFolderID), arg0, arg1)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockAccessControlRepository) FindAllByUserID(arg0 *gorm.DB, arg1 uint64) ([]entity.AccessControlEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]entity.AccessControlEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockAccessControlRepositoryMockRecorder) FindAllByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockAccessControlRepository)(nil).FindAllByUserID), arg0, arg1)
+}
+
+// FindOneByFolderIDAndUserID mocks base method.
+func (m *MockAccessControlRepository) FindOneByFolderIDAndUserID(arg0 *gorm.DB, arg1, arg2 uint64) (*entity.AccessControlEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByFolderIDAndUserID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.AccessControlEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByFolderIDAndUserID indicates an expected call of FindOneByFolderIDAndUserID.
+func (mr *MockAccessControlRepositoryMockRecorder) FindOneByFolderIDAndUserID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByFolderIDAndUserID", reflect.TypeOf((*MockAccessControlRepository)(nil).FindOneByFolderIDAndUserID), arg0, arg1, arg2)
+}
+
+// Remove mocks base method.
+func (m *MockAccessControlRepository) Remove(arg0 *gorm.DB, arg1 *entity.AccessControlEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAccessControlRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAccessControlRepository)(nil).Remove), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockAccessControlRepository) Update(arg0 *gorm.DB, arg1 *entity.AccessControlEntry) (*entity.AccessControlEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.AccessControlEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccessControlRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccessControlRepository)(nil).Update), arg0, arg1)
+}
@@ -1,51 +0,0 @@
-// Code generated by MockGen. DO NOT EDIT.
-// Source: internal/app/api/domain/repository/credential.go
-
-// Package mock_repository is a generated GoMock package.
-package mock_repository
-
-import (
-	entity "file-server/internal/app/api/domain/entity"
-	reflect "reflect"
-
-	gomock "github.com/golang/mock/gomock"
-	gorm "gorm.io/gorm"
-)
-
-// MockCredentialRepository is a mock of CredentialRepository interface.
-type MockCredentialRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockCredentialRepositoryMockRecorder
-}
-
-// MockCredentialRepositoryMockRecorder is the mock recorder for MockCredentialRepository.
-type MockCredentialRepositoryMockRecorder struct {
-	mock *MockCredentialRepository
-}
-
-// NewMockCredentialRepository creates a new mock instance.
-func NewMockCredentialRepository(ctrl *gomock.Controller) *MockCredentialRepository {
-	mock := &MockCredentialRepository{ctrl: ctrl}
-	mock.recorder = &MockCredentialRepositoryMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCredentialRepository) EXPECT() *MockCredentialRepositoryMockRecorder {
-	return m.recorder
-}
-
-// FindOne mocks base method.
-func (m *MockCredentialRepository) FindOne(arg0 *gorm.DB) (*entity.Credential, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOne", arg0)
-	ret0, _ := ret[0].(*entity.Credential)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindOne indicates an expected call of FindOne.
-func (mr *MockCredentialRepositoryMockRecorder) FindOne(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockCredentialRepository)(nil).FindOne), arg0)
-}
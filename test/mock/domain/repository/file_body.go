@@ -6,9 +6,11 @@ package mock_repository
 
 import (
 	entity "file-server/internal/app/api/domain/entity"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
 )
 
 // MockFileBodyRepository is a mock of FileBodyRepository interface.
@@ -34,25 +36,69 @@ func (m *MockFileBodyRepository) EXPECT() *MockFileBodyRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockFileBodyRepository) Copy(arg0 *gorm.DB, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockFileBodyRepositoryMockRecorder) Copy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFileBodyRepository)(nil).Copy), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
-func (m *MockFileBodyRepository) Create(arg0 *entity.FileBody) error {
+func (m *MockFileBodyRepository) Create(arg0 *gorm.DB, arg1 *entity.FileBody) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockFileBodyRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockFileBodyRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFileBodyRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockFileBodyRepository) FindAll() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFileBodyRepositoryMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFileBodyRepository)(nil).FindAll))
+}
+
+// PresignedURL mocks base method.
+func (m *MockFileBodyRepository) PresignedURL(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedURL", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedURL indicates an expected call of PresignedURL.
+func (mr *MockFileBodyRepositoryMockRecorder) PresignedURL(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFileBodyRepository)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedURL", reflect.TypeOf((*MockFileBodyRepository)(nil).PresignedURL), arg0, arg1)
 }
 
 // Read mocks base method.
-func (m *MockFileBodyRepository) Read(arg0 string) (*entity.FileBody, error) {
+func (m *MockFileBodyRepository) Read(arg0 string) (io.ReadSeekCloser, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Read", arg0)
-	ret0, _ := ret[0].(*entity.FileBody)
+	ret0, _ := ret[0].(io.ReadSeekCloser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -64,29 +110,29 @@ func (mr *MockFileBodyRepositoryMockRecorder) Read(arg0 interface{}) *gomock.Cal
 }
 
 // Remove mocks base method.
-func (m *MockFileBodyRepository) Remove(arg0 string) error {
+func (m *MockFileBodyRepository) Remove(arg0 *gorm.DB, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", arg0)
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockFileBodyRepositoryMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+func (mr *MockFileBodyRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFileBodyRepository)(nil).Remove), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFileBodyRepository)(nil).Remove), arg0, arg1)
 }
 
 // Update mocks base method.
-func (m *MockFileBodyRepository) Update(arg0, arg1 string) error {
+func (m *MockFileBodyRepository) Update(arg0 *gorm.DB, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockFileBodyRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockFileBodyRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFileBodyRepository)(nil).Update), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFileBodyRepository)(nil).Update), arg0, arg1, arg2)
 }
@@ -35,6 +35,21 @@ func (m *MockFileInfoRepository) EXPECT() *MockFileInfoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByFolderID mocks base method.
+func (m *MockFileInfoRepository) CountByFolderID(arg0 *gorm.DB, arg1 uint64, arg2 *entity.ListFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByFolderID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByFolderID indicates an expected call of CountByFolderID.
+func (mr *MockFileInfoRepositoryMockRecorder) CountByFolderID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByFolderID", reflect.TypeOf((*MockFileInfoRepository)(nil).CountByFolderID), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockFileInfoRepository) Create(arg0 *gorm.DB, arg1 *entity.FileInfo) (*entity.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,36 @@ func (mr *MockFileInfoRepositoryMockRecorder) Creates(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creates", reflect.TypeOf((*MockFileInfoRepository)(nil).Creates), arg0, arg1)
 }
 
+// FindAll mocks base method.
+func (m *MockFileInfoRepository) FindAll(arg0 *gorm.DB) ([]entity.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]entity.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFileInfoRepositoryMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFileInfoRepository)(nil).FindAll), arg0)
+}
+
+// FindAllByFolderID mocks base method.
+func (m *MockFileInfoRepository) FindAllByFolderID(arg0 *gorm.DB, arg1 uint64, arg2 *entity.ListQuery) ([]entity.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByFolderID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entity.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByFolderID indicates an expected call of FindAllByFolderID.
+func (mr *MockFileInfoRepositoryMockRecorder) FindAllByFolderID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByFolderID", reflect.TypeOf((*MockFileInfoRepository)(nil).FindAllByFolderID), arg0, arg1, arg2)
+}
+
 // FindOneByID mocks base method.
 func (m *MockFileInfoRepository) FindOneByID(arg0 *gorm.DB, arg1 uint64) (*entity.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +169,49 @@ func (mr *MockFileInfoRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFileInfoRepository)(nil).Remove), arg0, arg1)
 }
 
+// RestoreByPathPrefix mocks base method.
+func (m *MockFileInfoRepository) RestoreByPathPrefix(arg0 *gorm.DB, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPathPrefix", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreByPathPrefix indicates an expected call of RestoreByPathPrefix.
+func (mr *MockFileInfoRepositoryMockRecorder) RestoreByPathPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPathPrefix", reflect.TypeOf((*MockFileInfoRepository)(nil).RestoreByPathPrefix), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockFileInfoRepository) Search(arg0 *gorm.DB, arg1 *entity.ListQuery) ([]entity.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]entity.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockFileInfoRepositoryMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFileInfoRepository)(nil).Search), arg0, arg1)
+}
+
+// TrashByPathPrefix mocks base method.
+func (m *MockFileInfoRepository) TrashByPathPrefix(arg0 *gorm.DB, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashByPathPrefix", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrashByPathPrefix indicates an expected call of TrashByPathPrefix.
+func (mr *MockFileInfoRepositoryMockRecorder) TrashByPathPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashByPathPrefix", reflect.TypeOf((*MockFileInfoRepository)(nil).TrashByPathPrefix), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockFileInfoRepository) Update(arg0 *gorm.DB, arg1 *entity.FileInfo) (*entity.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/file_version.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockFileVersionRepository is a mock of FileVersionRepository interface.
+type MockFileVersionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileVersionRepositoryMockRecorder
+}
+
+// MockFileVersionRepositoryMockRecorder is the mock recorder for MockFileVersionRepository.
+type MockFileVersionRepositoryMockRecorder struct {
+	mock *MockFileVersionRepository
+}
+
+// NewMockFileVersionRepository creates a new mock instance.
+func NewMockFileVersionRepository(ctrl *gomock.Controller) *MockFileVersionRepository {
+	mock := &MockFileVersionRepository{ctrl: ctrl}
+	mock.recorder = &MockFileVersionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileVersionRepository) EXPECT() *MockFileVersionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFileVersionRepository) Create(arg0 *gorm.DB, arg1 *entity.FileVersion) (*entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFileVersionRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFileVersionRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockFileVersionRepository) FindAll(arg0 *gorm.DB) ([]entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFileVersionRepositoryMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFileVersionRepository)(nil).FindAll), arg0)
+}
+
+// FindAllByFileID mocks base method.
+func (m *MockFileVersionRepository) FindAllByFileID(arg0 *gorm.DB, arg1 uint64) ([]entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByFileID", arg0, arg1)
+	ret0, _ := ret[0].([]entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByFileID indicates an expected call of FindAllByFileID.
+func (mr *MockFileVersionRepositoryMockRecorder) FindAllByFileID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByFileID", reflect.TypeOf((*MockFileVersionRepository)(nil).FindAllByFileID), arg0, arg1)
+}
+
+// FindOneByID mocks base method.
+func (m *MockFileVersionRepository) FindOneByID(arg0 *gorm.DB, arg1 uint64) (*entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByID indicates an expected call of FindOneByID.
+func (mr *MockFileVersionRepositoryMockRecorder) FindOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockFileVersionRepository)(nil).FindOneByID), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockFileVersionRepository) Remove(arg0 *gorm.DB, arg1 *entity.FileVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockFileVersionRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFileVersionRepository)(nil).Remove), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockFileVersionRepository) Update(arg0 *gorm.DB, arg1 *entity.FileVersion) (*entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFileVersionRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFileVersionRepository)(nil).Update), arg0, arg1)
+}
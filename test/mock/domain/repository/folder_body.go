@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
 )
 
 // MockFolderBodyRepository is a mock of FolderBodyRepository interface.
@@ -35,58 +36,58 @@ func (m *MockFolderBodyRepository) EXPECT() *MockFolderBodyRepositoryMockRecorde
 }
 
 // Create mocks base method.
-func (m *MockFolderBodyRepository) Create(arg0 *entity.FolderBody) error {
+func (m *MockFolderBodyRepository) Create(arg0 *gorm.DB, arg1 *entity.FolderBody) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockFolderBodyRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockFolderBodyRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolderBodyRepository)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolderBodyRepository)(nil).Create), arg0, arg1)
 }
 
-// Read mocks base method.
-func (m *MockFolderBodyRepository) Read(arg0 string) (*entity.FolderBody, error) {
+// FindAll mocks base method.
+func (m *MockFolderBodyRepository) FindAll() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", arg0)
-	ret0, _ := ret[0].(*entity.FolderBody)
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Read indicates an expected call of Read.
-func (mr *MockFolderBodyRepositoryMockRecorder) Read(arg0 interface{}) *gomock.Call {
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFolderBodyRepositoryMockRecorder) FindAll() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFolderBodyRepository)(nil).Read), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFolderBodyRepository)(nil).FindAll))
 }
 
 // Remove mocks base method.
-func (m *MockFolderBodyRepository) Remove(arg0 string) error {
+func (m *MockFolderBodyRepository) Remove(arg0 *gorm.DB, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", arg0)
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockFolderBodyRepositoryMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+func (mr *MockFolderBodyRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFolderBodyRepository)(nil).Remove), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFolderBodyRepository)(nil).Remove), arg0, arg1)
 }
 
 // Update mocks base method.
-func (m *MockFolderBodyRepository) Update(arg0, arg1 string) error {
+func (m *MockFolderBodyRepository) Update(arg0 *gorm.DB, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockFolderBodyRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockFolderBodyRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFolderBodyRepository)(nil).Update), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFolderBodyRepository)(nil).Update), arg0, arg1, arg2)
 }
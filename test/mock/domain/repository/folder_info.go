@@ -35,6 +35,21 @@ func (m *MockFolderInfoRepository) EXPECT() *MockFolderInfoRepositoryMockRecorde
 	return m.recorder
 }
 
+// CountByParentFolderID mocks base method.
+func (m *MockFolderInfoRepository) CountByParentFolderID(arg0 *gorm.DB, arg1 uint64, arg2 *entity.ListFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByParentFolderID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByParentFolderID indicates an expected call of CountByParentFolderID.
+func (mr *MockFolderInfoRepositoryMockRecorder) CountByParentFolderID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByParentFolderID", reflect.TypeOf((*MockFolderInfoRepository)(nil).CountByParentFolderID), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockFolderInfoRepository) Create(arg0 *gorm.DB, arg1 *entity.FolderInfo) (*entity.FolderInfo, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,66 @@ func (mr *MockFolderInfoRepositoryMockRecorder) Create(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolderInfoRepository)(nil).Create), arg0, arg1)
 }
 
+// Creates mocks base method.
+func (m *MockFolderInfoRepository) Creates(arg0 *gorm.DB, arg1 []entity.FolderInfo) ([]entity.FolderInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Creates", arg0, arg1)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Creates indicates an expected call of Creates.
+func (mr *MockFolderInfoRepositoryMockRecorder) Creates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creates", reflect.TypeOf((*MockFolderInfoRepository)(nil).Creates), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockFolderInfoRepository) FindAll(arg0 *gorm.DB) ([]entity.FolderInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFolderInfoRepositoryMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFolderInfoRepository)(nil).FindAll), arg0)
+}
+
+// FindAllByParentFolderID mocks base method.
+func (m *MockFolderInfoRepository) FindAllByParentFolderID(arg0 *gorm.DB, arg1 uint64, arg2 *entity.ListQuery) ([]entity.FolderInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByParentFolderID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByParentFolderID indicates an expected call of FindAllByParentFolderID.
+func (mr *MockFolderInfoRepositoryMockRecorder) FindAllByParentFolderID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByParentFolderID", reflect.TypeOf((*MockFolderInfoRepository)(nil).FindAllByParentFolderID), arg0, arg1, arg2)
+}
+
+// FindAllByPaths mocks base method.
+func (m *MockFolderInfoRepository) FindAllByPaths(arg0 *gorm.DB, arg1 []string) ([]entity.FolderInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByPaths", arg0, arg1)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByPaths indicates an expected call of FindAllByPaths.
+func (mr *MockFolderInfoRepositoryMockRecorder) FindAllByPaths(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByPaths", reflect.TypeOf((*MockFolderInfoRepository)(nil).FindAllByPaths), arg0, arg1)
+}
+
 // FindOneByID mocks base method.
 func (m *MockFolderInfoRepository) FindOneByID(arg0 *gorm.DB, arg1 uint64) (*entity.FolderInfo, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +229,49 @@ func (mr *MockFolderInfoRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFolderInfoRepository)(nil).Remove), arg0, arg1)
 }
 
+// RestoreByPathPrefix mocks base method.
+func (m *MockFolderInfoRepository) RestoreByPathPrefix(arg0 *gorm.DB, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPathPrefix", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreByPathPrefix indicates an expected call of RestoreByPathPrefix.
+func (mr *MockFolderInfoRepositoryMockRecorder) RestoreByPathPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPathPrefix", reflect.TypeOf((*MockFolderInfoRepository)(nil).RestoreByPathPrefix), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockFolderInfoRepository) Search(arg0 *gorm.DB, arg1 *entity.ListQuery) ([]entity.FolderInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockFolderInfoRepositoryMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFolderInfoRepository)(nil).Search), arg0, arg1)
+}
+
+// TrashByPathPrefix mocks base method.
+func (m *MockFolderInfoRepository) TrashByPathPrefix(arg0 *gorm.DB, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashByPathPrefix", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrashByPathPrefix indicates an expected call of TrashByPathPrefix.
+func (mr *MockFolderInfoRepositoryMockRecorder) TrashByPathPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashByPathPrefix", reflect.TypeOf((*MockFolderInfoRepository)(nil).TrashByPathPrefix), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockFolderInfoRepository) Update(arg0 *gorm.DB, arg1 *entity.FolderInfo) (*entity.FolderInfo, error) {
 	m.ctrl.T.Helper()
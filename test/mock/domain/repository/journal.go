@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/journal.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockJournalRepository is a mock of JournalRepository interface.
+type MockJournalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalRepositoryMockRecorder
+}
+
+// MockJournalRepositoryMockRecorder is the mock recorder for MockJournalRepository.
+type MockJournalRepositoryMockRecorder struct {
+	mock *MockJournalRepository
+}
+
+// NewMockJournalRepository creates a new mock instance.
+func NewMockJournalRepository(ctrl *gomock.Controller) *MockJournalRepository {
+	mock := &MockJournalRepository{ctrl: ctrl}
+	mock.recorder = &MockJournalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalRepository) EXPECT() *MockJournalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockJournalRepository) Create(arg0 *gorm.DB, arg1 *entity.Journal) (*entity.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockJournalRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJournalRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockJournalRepository) FindAll(arg0 *gorm.DB) ([]entity.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]entity.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockJournalRepositoryMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockJournalRepository)(nil).FindAll), arg0)
+}
+
+// Remove mocks base method.
+func (m *MockJournalRepository) Remove(arg0 *gorm.DB, arg1 *entity.Journal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockJournalRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockJournalRepository)(nil).Remove), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockJournalRepository) Update(arg0 *gorm.DB, arg1 *entity.Journal) (*entity.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockJournalRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockJournalRepository)(nil).Update), arg0, arg1)
+}
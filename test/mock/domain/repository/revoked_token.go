@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/revoked_token.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockRevokedTokenRepository is a mock of RevokedTokenRepository interface.
+type MockRevokedTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokedTokenRepositoryMockRecorder
+}
+
+// MockRevokedTokenRepositoryMockRecorder is the mock recorder for MockRevokedTokenRepository.
+type MockRevokedTokenRepositoryMockRecorder struct {
+	mock *MockRevokedTokenRepository
+}
+
+// NewMockRevokedTokenRepository creates a new mock instance.
+func NewMockRevokedTokenRepository(ctrl *gomock.Controller) *MockRevokedTokenRepository {
+	mock := &MockRevokedTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRevokedTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokedTokenRepository) EXPECT() *MockRevokedTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRevokedTokenRepository) Create(arg0 *gorm.DB, arg1 *entity.RevokedToken) (*entity.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRevokedTokenRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRevokedTokenRepository)(nil).Create), arg0, arg1)
+}
+
+// FindOneByID mocks base method.
+func (m *MockRevokedTokenRepository) FindOneByID(arg0 *gorm.DB, arg1 string) (*entity.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByID indicates an expected call of FindOneByID.
+func (mr *MockRevokedTokenRepositoryMockRecorder) FindOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockRevokedTokenRepository)(nil).FindOneByID), arg0, arg1)
+}
+
+// RemoveByExpiresAtBefore mocks base method.
+func (m *MockRevokedTokenRepository) RemoveByExpiresAtBefore(arg0 *gorm.DB, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveByExpiresAtBefore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveByExpiresAtBefore indicates an expected call of RemoveByExpiresAtBefore.
+func (mr *MockRevokedTokenRepositoryMockRecorder) RemoveByExpiresAtBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveByExpiresAtBefore", reflect.TypeOf((*MockRevokedTokenRepository)(nil).RemoveByExpiresAtBefore), arg0, arg1)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/share_link.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockShareLinkRepository is a mock of ShareLinkRepository interface.
+type MockShareLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkRepositoryMockRecorder
+}
+
+// MockShareLinkRepositoryMockRecorder is the mock recorder for MockShareLinkRepository.
+type MockShareLinkRepositoryMockRecorder struct {
+	mock *MockShareLinkRepository
+}
+
+// NewMockShareLinkRepository creates a new mock instance.
+func NewMockShareLinkRepository(ctrl *gomock.Controller) *MockShareLinkRepository {
+	mock := &MockShareLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockShareLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareLinkRepository) EXPECT() *MockShareLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareLinkRepository) Create(arg0 *gorm.DB, arg1 *entity.ShareLink) (*entity.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareLinkRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockShareLinkRepository) FindAllByUserID(arg0 *gorm.DB, arg1 uint64) ([]entity.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]entity.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockShareLinkRepositoryMockRecorder) FindAllByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockShareLinkRepository)(nil).FindAllByUserID), arg0, arg1)
+}
+
+// FindOneByID mocks base method.
+func (m *MockShareLinkRepository) FindOneByID(arg0 *gorm.DB, arg1 uint64) (*entity.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByID indicates an expected call of FindOneByID.
+func (mr *MockShareLinkRepositoryMockRecorder) FindOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockShareLinkRepository)(nil).FindOneByID), arg0, arg1)
+}
+
+// FindOneByToken mocks base method.
+func (m *MockShareLinkRepository) FindOneByToken(arg0 *gorm.DB, arg1 string) (*entity.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByToken", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByToken indicates an expected call of FindOneByToken.
+func (mr *MockShareLinkRepositoryMockRecorder) FindOneByToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByToken", reflect.TypeOf((*MockShareLinkRepository)(nil).FindOneByToken), arg0, arg1)
+}
+
+// IncrementDownloadCount mocks base method.
+func (m *MockShareLinkRepository) IncrementDownloadCount(arg0 *gorm.DB, arg1 *entity.ShareLink) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementDownloadCount", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementDownloadCount indicates an expected call of IncrementDownloadCount.
+func (mr *MockShareLinkRepositoryMockRecorder) IncrementDownloadCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementDownloadCount", reflect.TypeOf((*MockShareLinkRepository)(nil).IncrementDownloadCount), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockShareLinkRepository) Remove(arg0 *gorm.DB, arg1 *entity.ShareLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockShareLinkRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockShareLinkRepository)(nil).Remove), arg0, arg1)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/trash_item.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTrashItemRepository is a mock of TrashItemRepository interface.
+type MockTrashItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashItemRepositoryMockRecorder
+}
+
+// MockTrashItemRepositoryMockRecorder is the mock recorder for MockTrashItemRepository.
+type MockTrashItemRepositoryMockRecorder struct {
+	mock *MockTrashItemRepository
+}
+
+// NewMockTrashItemRepository creates a new mock instance.
+func NewMockTrashItemRepository(ctrl *gomock.Controller) *MockTrashItemRepository {
+	mock := &MockTrashItemRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashItemRepository) EXPECT() *MockTrashItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTrashItemRepository) Create(arg0 *gorm.DB, arg1 *entity.TrashItem) (*entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTrashItemRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTrashItemRepository)(nil).Create), arg0, arg1)
+}
+
+// FindAllByCreatedAtBefore mocks base method.
+func (m *MockTrashItemRepository) FindAllByCreatedAtBefore(arg0 *gorm.DB, arg1 time.Time) ([]entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCreatedAtBefore", arg0, arg1)
+	ret0, _ := ret[0].([]entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCreatedAtBefore indicates an expected call of FindAllByCreatedAtBefore.
+func (mr *MockTrashItemRepositoryMockRecorder) FindAllByCreatedAtBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCreatedAtBefore", reflect.TypeOf((*MockTrashItemRepository)(nil).FindAllByCreatedAtBefore), arg0, arg1)
+}
+
+// FindAllByParentFolderIDs mocks base method.
+func (m *MockTrashItemRepository) FindAllByParentFolderIDs(arg0 *gorm.DB, arg1 []uint64) ([]entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByParentFolderIDs", arg0, arg1)
+	ret0, _ := ret[0].([]entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByParentFolderIDs indicates an expected call of FindAllByParentFolderIDs.
+func (mr *MockTrashItemRepositoryMockRecorder) FindAllByParentFolderIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByParentFolderIDs", reflect.TypeOf((*MockTrashItemRepository)(nil).FindAllByParentFolderIDs), arg0, arg1)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockTrashItemRepository) FindAllByUserID(arg0 *gorm.DB, arg1 uint64) ([]entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockTrashItemRepositoryMockRecorder) FindAllByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockTrashItemRepository)(nil).FindAllByUserID), arg0, arg1)
+}
+
+// FindOneByID mocks base method.
+func (m *MockTrashItemRepository) FindOneByID(arg0 *gorm.DB, arg1 uint64) (*entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByID indicates an expected call of FindOneByID.
+func (mr *MockTrashItemRepositoryMockRecorder) FindOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockTrashItemRepository)(nil).FindOneByID), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockTrashItemRepository) Remove(arg0 *gorm.DB, arg1 *entity.TrashItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockTrashItemRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTrashItemRepository)(nil).Remove), arg0, arg1)
+}
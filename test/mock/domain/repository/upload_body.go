@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/upload_body.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadBodyRepository is a mock of UploadBodyRepository interface.
+type MockUploadBodyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadBodyRepositoryMockRecorder
+}
+
+// MockUploadBodyRepositoryMockRecorder is the mock recorder for MockUploadBodyRepository.
+type MockUploadBodyRepositoryMockRecorder struct {
+	mock *MockUploadBodyRepository
+}
+
+// NewMockUploadBodyRepository creates a new mock instance.
+func NewMockUploadBodyRepository(ctrl *gomock.Controller) *MockUploadBodyRepository {
+	mock := &MockUploadBodyRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadBodyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadBodyRepository) EXPECT() *MockUploadBodyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUploadBodyRepository) Create(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadBodyRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadBodyRepository)(nil).Create), arg0)
+}
+
+// Read mocks base method.
+func (m *MockUploadBodyRepository) Read(arg0 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockUploadBodyRepositoryMockRecorder) Read(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockUploadBodyRepository)(nil).Read), arg0)
+}
+
+// Remove mocks base method.
+func (m *MockUploadBodyRepository) Remove(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUploadBodyRepositoryMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUploadBodyRepository)(nil).Remove), arg0)
+}
+
+// Write mocks base method.
+func (m *MockUploadBodyRepository) Write(arg0 string, arg1 int64, arg2 io.Reader) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockUploadBodyRepositoryMockRecorder) Write(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockUploadBodyRepository)(nil).Write), arg0, arg1, arg2)
+}
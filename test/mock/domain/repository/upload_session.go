@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/repository/upload_session.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockUploadSessionRepository is a mock of UploadSessionRepository interface.
+type MockUploadSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadSessionRepositoryMockRecorder
+}
+
+// MockUploadSessionRepositoryMockRecorder is the mock recorder for MockUploadSessionRepository.
+type MockUploadSessionRepositoryMockRecorder struct {
+	mock *MockUploadSessionRepository
+}
+
+// NewMockUploadSessionRepository creates a new mock instance.
+func NewMockUploadSessionRepository(ctrl *gomock.Controller) *MockUploadSessionRepository {
+	mock := &MockUploadSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadSessionRepository) EXPECT() *MockUploadSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUploadSessionRepository) Create(arg0 *gorm.DB, arg1 *entity.UploadSession) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadSessionRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadSessionRepository)(nil).Create), arg0, arg1)
+}
+
+// FindByUpdatedAtBefore mocks base method.
+func (m *MockUploadSessionRepository) FindByUpdatedAtBefore(arg0 *gorm.DB, arg1 time.Time) ([]entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUpdatedAtBefore", arg0, arg1)
+	ret0, _ := ret[0].([]entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUpdatedAtBefore indicates an expected call of FindByUpdatedAtBefore.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindByUpdatedAtBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUpdatedAtBefore", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindByUpdatedAtBefore), arg0, arg1)
+}
+
+// FindOneByID mocks base method.
+func (m *MockUploadSessionRepository) FindOneByID(arg0 *gorm.DB, arg1 string) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByID indicates an expected call of FindOneByID.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByID", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindOneByID), arg0, arg1)
+}
+
+// FindOneByIDWithLock mocks base method.
+func (m *MockUploadSessionRepository) FindOneByIDWithLock(arg0 *gorm.DB, arg1 string) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByIDWithLock", arg0, arg1)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByIDWithLock indicates an expected call of FindOneByIDWithLock.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindOneByIDWithLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIDWithLock", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindOneByIDWithLock), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockUploadSessionRepository) Remove(arg0 *gorm.DB, arg1 *entity.UploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUploadSessionRepositoryMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUploadSessionRepository)(nil).Remove), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockUploadSessionRepository) Update(arg0 *gorm.DB, arg1 *entity.UploadSession) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUploadSessionRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUploadSessionRepository)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/service/access_control.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAccessControlService is a mock of AccessControlService interface.
+type MockAccessControlService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessControlServiceMockRecorder
+}
+
+// MockAccessControlServiceMockRecorder is the mock recorder for MockAccessControlService.
+type MockAccessControlServiceMockRecorder struct {
+	mock *MockAccessControlService
+}
+
+// NewMockAccessControlService creates a new mock instance.
+func NewMockAccessControlService(ctrl *gomock.Controller) *MockAccessControlService {
+	mock := &MockAccessControlService{ctrl: ctrl}
+	mock.recorder = &MockAccessControlServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessControlService) EXPECT() *MockAccessControlServiceMockRecorder {
+	return m.recorder
+}
+
+// FilePermission mocks base method.
+func (m *MockAccessControlService) FilePermission(arg0 *gorm.DB, arg1 entity.Principal, arg2 *entity.FileInfo) (entity.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilePermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entity.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilePermission indicates an expected call of FilePermission.
+func (mr *MockAccessControlServiceMockRecorder) FilePermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilePermission", reflect.TypeOf((*MockAccessControlService)(nil).FilePermission), arg0, arg1, arg2)
+}
+
+// Filter mocks base method.
+func (m *MockAccessControlService) Filter(arg0 *gorm.DB, arg1 entity.Principal, arg2 *entity.FolderInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockAccessControlServiceMockRecorder) Filter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockAccessControlService)(nil).Filter), arg0, arg1, arg2)
+}
+
+// FilterReadable mocks base method.
+func (m *MockAccessControlService) FilterReadable(arg0 *gorm.DB, arg1 entity.Principal, arg2 *entity.FolderInfo, arg3 []entity.FolderInfo, arg4 []entity.FileInfo) ([]entity.FolderInfo, []entity.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterReadable", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]entity.FolderInfo)
+	ret1, _ := ret[1].([]entity.FileInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FilterReadable indicates an expected call of FilterReadable.
+func (mr *MockAccessControlServiceMockRecorder) FilterReadable(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterReadable", reflect.TypeOf((*MockAccessControlService)(nil).FilterReadable), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FolderPermission mocks base method.
+func (m *MockAccessControlService) FolderPermission(arg0 *gorm.DB, arg1 entity.Principal, arg2 *entity.FolderInfo) (entity.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolderPermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entity.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolderPermission indicates an expected call of FolderPermission.
+func (mr *MockAccessControlServiceMockRecorder) FolderPermission(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolderPermission", reflect.TypeOf((*MockAccessControlService)(nil).FolderPermission), arg0, arg1, arg2)
+}
+
+// Visibility mocks base method.
+func (m *MockAccessControlService) Visibility(arg0 *gorm.DB, arg1 entity.Principal, arg2 *entity.FolderInfo) (*entity.Visibility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Visibility", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Visibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Visibility indicates an expected call of Visibility.
+func (mr *MockAccessControlServiceMockRecorder) Visibility(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Visibility", reflect.TypeOf((*MockAccessControlService)(nil).Visibility), arg0, arg1, arg2)
+}
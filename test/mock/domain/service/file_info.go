@@ -35,17 +35,17 @@ func (m *MockFileInfoService) EXPECT() *MockFileInfoServiceMockRecorder {
 	return m.recorder
 }
 
-// IsExists mocks base method.
-func (m *MockFileInfoService) IsExists(arg0 *gorm.DB, arg1 *entity.FileInfo) (bool, error) {
+// ResolveConflict mocks base method.
+func (m *MockFileInfoService) ResolveConflict(arg0 *gorm.DB, arg1 *entity.FileInfo, arg2 entity.ConflictMode) (*entity.FileInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ResolveConflict", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.FileInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsExists indicates an expected call of IsExists.
-func (mr *MockFileInfoServiceMockRecorder) IsExists(arg0, arg1 interface{}) *gomock.Call {
+// ResolveConflict indicates an expected call of ResolveConflict.
+func (mr *MockFileInfoServiceMockRecorder) ResolveConflict(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExists", reflect.TypeOf((*MockFileInfoService)(nil).IsExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveConflict", reflect.TypeOf((*MockFileInfoService)(nil).ResolveConflict), arg0, arg1, arg2)
 }
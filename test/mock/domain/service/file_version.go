@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/service/file_version.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockFileVersionService is a mock of FileVersionService interface.
+type MockFileVersionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileVersionServiceMockRecorder
+}
+
+// MockFileVersionServiceMockRecorder is the mock recorder for MockFileVersionService.
+type MockFileVersionServiceMockRecorder struct {
+	mock *MockFileVersionService
+}
+
+// NewMockFileVersionService creates a new mock instance.
+func NewMockFileVersionService(ctrl *gomock.Controller) *MockFileVersionService {
+	mock := &MockFileVersionService{ctrl: ctrl}
+	mock.recorder = &MockFileVersionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileVersionService) EXPECT() *MockFileVersionServiceMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockFileVersionService) Archive(arg0 *gorm.DB, arg1 *entity.FileInfo) (*entity.FileVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(*entity.FileVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockFileVersionServiceMockRecorder) Archive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockFileVersionService)(nil).Archive), arg0, arg1)
+}
+
+// Prune mocks base method.
+func (m *MockFileVersionService) Prune(arg0 *gorm.DB, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockFileVersionServiceMockRecorder) Prune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockFileVersionService)(nil).Prune), arg0, arg1)
+}
@@ -35,17 +35,17 @@ func (m *MockFolderInfoService) EXPECT() *MockFolderInfoServiceMockRecorder {
 	return m.recorder
 }
 
-// IsExists mocks base method.
-func (m *MockFolderInfoService) IsExists(arg0 *gorm.DB, arg1 *entity.FolderInfo) (bool, error) {
+// ResolveConflict mocks base method.
+func (m *MockFolderInfoService) ResolveConflict(arg0 *gorm.DB, arg1 *entity.FolderInfo, arg2 entity.ConflictMode) (*entity.FolderInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ResolveConflict", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.FolderInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsExists indicates an expected call of IsExists.
-func (mr *MockFolderInfoServiceMockRecorder) IsExists(arg0, arg1 interface{}) *gomock.Call {
+// ResolveConflict indicates an expected call of ResolveConflict.
+func (mr *MockFolderInfoServiceMockRecorder) ResolveConflict(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExists", reflect.TypeOf((*MockFolderInfoService)(nil).IsExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveConflict", reflect.TypeOf((*MockFolderInfoService)(nil).ResolveConflict), arg0, arg1, arg2)
 }
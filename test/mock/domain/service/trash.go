@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/domain/service/trash.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockTrashService is a mock of TrashService interface.
+type MockTrashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashServiceMockRecorder
+}
+
+// MockTrashServiceMockRecorder is the mock recorder for MockTrashService.
+type MockTrashServiceMockRecorder struct {
+	mock *MockTrashService
+}
+
+// NewMockTrashService creates a new mock instance.
+func NewMockTrashService(ctrl *gomock.Controller) *MockTrashService {
+	mock := &MockTrashService{ctrl: ctrl}
+	mock.recorder = &MockTrashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashService) EXPECT() *MockTrashServiceMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockTrashService) Purge(arg0 *gorm.DB, arg1 *entity.TrashItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashServiceMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrashService)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockTrashService) Restore(arg0 *gorm.DB, arg1 *entity.TrashItem, arg2 *entity.FolderInfo, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashServiceMockRecorder) Restore(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashService)(nil).Restore), arg0, arg1, arg2, arg3)
+}
+
+// TrashFile mocks base method.
+func (m *MockTrashService) TrashFile(arg0 *gorm.DB, arg1 uint64, arg2 *entity.FileInfo) (*entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrashFile indicates an expected call of TrashFile.
+func (mr *MockTrashServiceMockRecorder) TrashFile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashFile", reflect.TypeOf((*MockTrashService)(nil).TrashFile), arg0, arg1, arg2)
+}
+
+// TrashFolder mocks base method.
+func (m *MockTrashService) TrashFolder(arg0 *gorm.DB, arg1 uint64, arg2 *entity.FolderInfo) (*entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashFolder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrashFolder indicates an expected call of TrashFolder.
+func (mr *MockTrashServiceMockRecorder) TrashFolder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashFolder", reflect.TypeOf((*MockTrashService)(nil).TrashFolder), arg0, arg1, arg2)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/access_control.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAccessControlUsecase is a mock of AccessControlUsecase interface.
+type MockAccessControlUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessControlUsecaseMockRecorder
+}
+
+// MockAccessControlUsecaseMockRecorder is the mock recorder for MockAccessControlUsecase.
+type MockAccessControlUsecaseMockRecorder struct {
+	mock *MockAccessControlUsecase
+}
+
+// NewMockAccessControlUsecase creates a new mock instance.
+func NewMockAccessControlUsecase(ctrl *gomock.Controller) *MockAccessControlUsecase {
+	mock := &MockAccessControlUsecase{ctrl: ctrl}
+	mock.recorder = &MockAccessControlUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessControlUsecase) EXPECT() *MockAccessControlUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockAccessControlUsecase) FindAll(arg0 uint64, arg1 entity.Principal) ([]dto.AccessControlDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].([]dto.AccessControlDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAccessControlUsecaseMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAccessControlUsecase)(nil).FindAll), arg0, arg1)
+}
+
+// Grant mocks base method.
+func (m *MockAccessControlUsecase) Grant(arg0, arg1 uint64, arg2 string, arg3 entity.Principal) (*dto.AccessControlDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.AccessControlDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grant indicates an expected call of Grant.
+func (mr *MockAccessControlUsecaseMockRecorder) Grant(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockAccessControlUsecase)(nil).Grant), arg0, arg1, arg2, arg3)
+}
+
+// Revoke mocks base method.
+func (m *MockAccessControlUsecase) Revoke(arg0, arg1 uint64, arg2 entity.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAccessControlUsecaseMockRecorder) Revoke(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAccessControlUsecase)(nil).Revoke), arg0, arg1, arg2)
+}
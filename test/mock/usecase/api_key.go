@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/api_key.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPIKeyUsecase is a mock of APIKeyUsecase interface.
+type MockAPIKeyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyUsecaseMockRecorder
+}
+
+// MockAPIKeyUsecaseMockRecorder is the mock recorder for MockAPIKeyUsecase.
+type MockAPIKeyUsecaseMockRecorder struct {
+	mock *MockAPIKeyUsecase
+}
+
+// NewMockAPIKeyUsecase creates a new mock instance.
+func NewMockAPIKeyUsecase(ctrl *gomock.Controller) *MockAPIKeyUsecase {
+	mock := &MockAPIKeyUsecase{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyUsecase) EXPECT() *MockAPIKeyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAPIKeyUsecase) Authenticate(arg0 string) (*dto.UserDTO, *dto.APIKeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0)
+	ret0, _ := ret[0].(*dto.UserDTO)
+	ret1, _ := ret[1].(*dto.APIKeyDTO)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAPIKeyUsecaseMockRecorder) Authenticate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAPIKeyUsecase)(nil).Authenticate), arg0)
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyUsecase) Create(arg0, arg1 string, arg2 *uint64, arg3 time.Time, arg4 entity.Principal) (*dto.APIKeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.APIKeyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindAll mocks base method.
+func (m *MockAPIKeyUsecase) FindAll(arg0 entity.Principal) ([]dto.APIKeyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]dto.APIKeyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAPIKeyUsecaseMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAPIKeyUsecase)(nil).FindAll), arg0)
+}
+
+// Remove mocks base method.
+func (m *MockAPIKeyUsecase) Remove(arg0 uint64, arg1 entity.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAPIKeyUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAPIKeyUsecase)(nil).Remove), arg0, arg1)
+}
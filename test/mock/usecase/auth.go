@@ -7,6 +7,7 @@ package mock_usecase
 import (
 	dto "file-server/internal/app/api/usecase/dto"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,17 +35,75 @@ func (m *MockAuthUsecase) EXPECT() *MockAuthUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Authenticate mocks base method.
+func (m *MockAuthUsecase) Authenticate(arg0 string) (*dto.UserDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0)
+	ret0, _ := ret[0].(*dto.UserDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAuthUsecaseMockRecorder) Authenticate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthUsecase)(nil).Authenticate), arg0)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthUsecase) Refresh(arg0 string) (*dto.AuthDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0)
+	ret0, _ := ret[0].(*dto.AuthDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthUsecaseMockRecorder) Refresh(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthUsecase)(nil).Refresh), arg0)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockAuthUsecase) RemoveExpired(arg0 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockAuthUsecaseMockRecorder) RemoveExpired(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockAuthUsecase)(nil).RemoveExpired), arg0)
+}
+
 // Signin mocks base method.
-func (m *MockAuthUsecase) Signin(arg0 string) (*dto.AuthDTO, error) {
+func (m *MockAuthUsecase) Signin(arg0, arg1 string) (*dto.AuthDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Signin", arg0)
+	ret := m.ctrl.Call(m, "Signin", arg0, arg1)
 	ret0, _ := ret[0].(*dto.AuthDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Signin indicates an expected call of Signin.
-func (mr *MockAuthUsecaseMockRecorder) Signin(arg0 interface{}) *gomock.Call {
+func (mr *MockAuthUsecaseMockRecorder) Signin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signin", reflect.TypeOf((*MockAuthUsecase)(nil).Signin), arg0, arg1)
+}
+
+// Signout mocks base method.
+func (m *MockAuthUsecase) Signout(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Signout indicates an expected call of Signout.
+func (mr *MockAuthUsecaseMockRecorder) Signout(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signin", reflect.TypeOf((*MockAuthUsecase)(nil).Signin), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signout", reflect.TypeOf((*MockAuthUsecase)(nil).Signout), arg0, arg1)
 }
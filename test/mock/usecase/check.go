@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/check.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCheckUsecase is a mock of CheckUsecase interface.
+type MockCheckUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckUsecaseMockRecorder
+}
+
+// MockCheckUsecaseMockRecorder is the mock recorder for MockCheckUsecase.
+type MockCheckUsecaseMockRecorder struct {
+	mock *MockCheckUsecase
+}
+
+// NewMockCheckUsecase creates a new mock instance.
+func NewMockCheckUsecase(ctrl *gomock.Controller) *MockCheckUsecase {
+	mock := &MockCheckUsecase{ctrl: ctrl}
+	mock.recorder = &MockCheckUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckUsecase) EXPECT() *MockCheckUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockCheckUsecase) Check() ([]dto.InconsistencyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check")
+	ret0, _ := ret[0].([]dto.InconsistencyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockCheckUsecaseMockRecorder) Check() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockCheckUsecase)(nil).Check))
+}
+
+// Repair mocks base method.
+func (m *MockCheckUsecase) Repair(arg0 bool) ([]dto.InconsistencyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repair", arg0)
+	ret0, _ := ret[0].([]dto.InconsistencyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repair indicates an expected call of Repair.
+func (mr *MockCheckUsecaseMockRecorder) Repair(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockCheckUsecase)(nil).Repair), arg0)
+}
@@ -5,8 +5,10 @@
 package mock_usecase
 
 import (
+	entity "file-server/internal/app/api/domain/entity"
 	dto "file-server/internal/app/api/usecase/dto"
 	types "file-server/internal/pkg/types"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,52 +38,82 @@ func (m *MockFileUsecase) EXPECT() *MockFileUsecaseMockRecorder {
 }
 
 // Copy mocks base method.
-func (m *MockFileUsecase) Copy(arg0, arg1 uint64, arg2 bool) (*dto.FileInfoDTO, error) {
+func (m *MockFileUsecase) Copy(arg0, arg1 uint64, arg2 entity.ConflictMode, arg3 entity.Principal) (*dto.FileInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FileInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Copy indicates an expected call of Copy.
-func (mr *MockFileUsecaseMockRecorder) Copy(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFileUsecaseMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFileUsecase)(nil).Copy), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFileUsecase)(nil).Copy), arg0, arg1, arg2, arg3)
 }
 
 // Create mocks base method.
-func (m *MockFileUsecase) Create(arg0 uint64, arg1 bool, arg2 []types.File) ([]dto.FileInfoDTO, error) {
+func (m *MockFileUsecase) Create(arg0 uint64, arg1 bool, arg2 []types.File, arg3 entity.ConflictMode, arg4 entity.Principal) ([]dto.FileInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]dto.FileInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockFileUsecaseMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFileUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFileUsecase)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFileUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Extract mocks base method.
+func (m *MockFileUsecase) Extract(arg0, arg1 uint64, arg2 entity.ConflictMode, arg3 entity.Principal) ([]dto.FileInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extract", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]dto.FileInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Extract indicates an expected call of Extract.
+func (mr *MockFileUsecaseMockRecorder) Extract(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extract", reflect.TypeOf((*MockFileUsecase)(nil).Extract), arg0, arg1, arg2, arg3)
 }
 
 // Move mocks base method.
-func (m *MockFileUsecase) Move(arg0, arg1 uint64, arg2 bool) (*dto.FileInfoDTO, error) {
+func (m *MockFileUsecase) Move(arg0, arg1 uint64, arg2 entity.ConflictMode, arg3 entity.Principal) (*dto.FileInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FileInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Move indicates an expected call of Move.
-func (mr *MockFileUsecaseMockRecorder) Move(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFileUsecaseMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFileUsecase)(nil).Move), arg0, arg1, arg2, arg3)
+}
+
+// Overwrite mocks base method.
+func (m *MockFileUsecase) Overwrite(arg0 uint64, arg1 io.Reader, arg2 entity.Principal) (*dto.FileInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overwrite", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FileInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Overwrite indicates an expected call of Overwrite.
+func (mr *MockFileUsecaseMockRecorder) Overwrite(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFileUsecase)(nil).Move), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overwrite", reflect.TypeOf((*MockFileUsecase)(nil).Overwrite), arg0, arg1, arg2)
 }
 
 // Read mocks base method.
-func (m *MockFileUsecase) Read(arg0 uint64, arg1 bool) (*dto.FileBodyDTO, error) {
+func (m *MockFileUsecase) Read(arg0 uint64, arg1 entity.Principal) (*dto.FileBodyDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Read", arg0, arg1)
 	ret0, _ := ret[0].(*dto.FileBodyDTO)
@@ -96,7 +128,7 @@ func (mr *MockFileUsecaseMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call
 }
 
 // Remove mocks base method.
-func (m *MockFileUsecase) Remove(arg0 uint64, arg1 bool) error {
+func (m *MockFileUsecase) Remove(arg0 uint64, arg1 entity.Principal) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -110,7 +142,7 @@ func (mr *MockFileUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Ca
 }
 
 // Update mocks base method.
-func (m *MockFileUsecase) Update(arg0 uint64, arg1 string, arg2, arg3 bool) (*dto.FileInfoDTO, error) {
+func (m *MockFileUsecase) Update(arg0 uint64, arg1 string, arg2 bool, arg3 entity.Principal) (*dto.FileInfoDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FileInfoDTO)
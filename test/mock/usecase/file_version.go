@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/file_version.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFileVersionUsecase is a mock of FileVersionUsecase interface.
+type MockFileVersionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileVersionUsecaseMockRecorder
+}
+
+// MockFileVersionUsecaseMockRecorder is the mock recorder for MockFileVersionUsecase.
+type MockFileVersionUsecaseMockRecorder struct {
+	mock *MockFileVersionUsecase
+}
+
+// NewMockFileVersionUsecase creates a new mock instance.
+func NewMockFileVersionUsecase(ctrl *gomock.Controller) *MockFileVersionUsecase {
+	mock := &MockFileVersionUsecase{ctrl: ctrl}
+	mock.recorder = &MockFileVersionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileVersionUsecase) EXPECT() *MockFileVersionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockFileVersionUsecase) FindAll(arg0 uint64, arg1 entity.Principal) ([]dto.FileVersionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].([]dto.FileVersionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFileVersionUsecaseMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFileVersionUsecase)(nil).FindAll), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockFileVersionUsecase) Read(arg0, arg1 uint64, arg2 entity.Principal) (*dto.FileBodyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FileBodyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockFileVersionUsecaseMockRecorder) Read(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFileVersionUsecase)(nil).Read), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockFileVersionUsecase) Restore(arg0, arg1 uint64, arg2 entity.Principal) (*dto.FileInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FileInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockFileVersionUsecaseMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockFileVersionUsecase)(nil).Restore), arg0, arg1, arg2)
+}
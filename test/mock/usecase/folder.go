@@ -5,6 +5,8 @@
 package mock_usecase
 
 import (
+	context "context"
+	entity "file-server/internal/app/api/domain/entity"
 	dto "file-server/internal/app/api/usecase/dto"
 	reflect "reflect"
 
@@ -34,38 +36,68 @@ func (m *MockFolderUsecase) EXPECT() *MockFolderUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockFolderUsecase) Archive(arg0 context.Context, arg1, arg2 []uint64, arg3 entity.ArchiveFormat, arg4 entity.Principal) (*dto.FolderBodyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.FolderBodyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockFolderUsecaseMockRecorder) Archive(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockFolderUsecase)(nil).Archive), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Copy mocks base method.
-func (m *MockFolderUsecase) Copy(arg0, arg1 uint64, arg2 bool) (*dto.FolderInfoDTO, error) {
+func (m *MockFolderUsecase) Copy(arg0, arg1 uint64, arg2 entity.ConflictMode, arg3 entity.Principal) (*dto.FolderInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FolderInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Copy indicates an expected call of Copy.
-func (mr *MockFolderUsecaseMockRecorder) Copy(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFolderUsecaseMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFolderUsecase)(nil).Copy), arg0, arg1, arg2, arg3)
+}
+
+// Count mocks base method.
+func (m *MockFolderUsecase) Count(arg0 uint64, arg1 *entity.ListFilter, arg2 entity.Principal) (*dto.FolderCountDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FolderCountDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockFolderUsecaseMockRecorder) Count(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockFolderUsecase)(nil).Copy), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockFolderUsecase)(nil).Count), arg0, arg1, arg2)
 }
 
 // Create mocks base method.
-func (m *MockFolderUsecase) Create(arg0 uint64, arg1 string, arg2 bool) (*dto.FolderInfoDTO, error) {
+func (m *MockFolderUsecase) Create(arg0 uint64, arg1 string, arg2 bool, arg3 entity.ConflictMode, arg4 entity.Principal) (*dto.FolderInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*dto.FolderInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockFolderUsecaseMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFolderUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolderUsecase)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolderUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4)
 }
 
 // FindOne mocks base method.
-func (m *MockFolderUsecase) FindOne(arg0 string, arg1 bool) (*dto.FolderInfoDTO, error) {
+func (m *MockFolderUsecase) FindOne(arg0 string, arg1 entity.Principal) (*dto.FolderInfoDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindOne", arg0, arg1)
 	ret0, _ := ret[0].(*dto.FolderInfoDTO)
@@ -79,38 +111,53 @@ func (mr *MockFolderUsecaseMockRecorder) FindOne(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockFolderUsecase)(nil).FindOne), arg0, arg1)
 }
 
+// List mocks base method.
+func (m *MockFolderUsecase) List(arg0 uint64, arg1 *entity.ListQuery, arg2 entity.Principal) (*dto.FolderListDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FolderListDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFolderUsecaseMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFolderUsecase)(nil).List), arg0, arg1, arg2)
+}
+
 // Move mocks base method.
-func (m *MockFolderUsecase) Move(arg0, arg1 uint64, arg2 bool) (*dto.FolderInfoDTO, error) {
+func (m *MockFolderUsecase) Move(arg0, arg1 uint64, arg2 entity.ConflictMode, arg3 entity.Principal) (*dto.FolderInfoDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FolderInfoDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Move indicates an expected call of Move.
-func (mr *MockFolderUsecaseMockRecorder) Move(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFolderUsecaseMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFolderUsecase)(nil).Move), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFolderUsecase)(nil).Move), arg0, arg1, arg2, arg3)
 }
 
 // Read mocks base method.
-func (m *MockFolderUsecase) Read(arg0 uint64, arg1 bool) (*dto.FolderBodyDTO, error) {
+func (m *MockFolderUsecase) Read(arg0 context.Context, arg1 uint64, arg2 entity.Principal) (*dto.FolderBodyDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret := m.ctrl.Call(m, "Read", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*dto.FolderBodyDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Read indicates an expected call of Read.
-func (mr *MockFolderUsecaseMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockFolderUsecaseMockRecorder) Read(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFolderUsecase)(nil).Read), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFolderUsecase)(nil).Read), arg0, arg1, arg2)
 }
 
 // Remove mocks base method.
-func (m *MockFolderUsecase) Remove(arg0 uint64, arg1 bool) error {
+func (m *MockFolderUsecase) Remove(arg0 uint64, arg1 entity.Principal) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -123,8 +170,23 @@ func (mr *MockFolderUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFolderUsecase)(nil).Remove), arg0, arg1)
 }
 
+// Search mocks base method.
+func (m *MockFolderUsecase) Search(arg0 uint64, arg1 *entity.ListQuery, arg2 entity.Principal) (*dto.FolderListDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.FolderListDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockFolderUsecaseMockRecorder) Search(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFolderUsecase)(nil).Search), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
-func (m *MockFolderUsecase) Update(arg0 uint64, arg1 string, arg2, arg3 bool) (*dto.FolderInfoDTO, error) {
+func (m *MockFolderUsecase) Update(arg0 uint64, arg1 string, arg2 bool, arg3 entity.Principal) (*dto.FolderInfoDTO, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*dto.FolderInfoDTO)
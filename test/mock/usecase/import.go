@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/import.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "file-server/internal/app/api/usecase/dto"
+	fs "io/fs"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImportUsecase is a mock of ImportUsecase interface.
+type MockImportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportUsecaseMockRecorder
+}
+
+// MockImportUsecaseMockRecorder is the mock recorder for MockImportUsecase.
+type MockImportUsecaseMockRecorder struct {
+	mock *MockImportUsecase
+}
+
+// NewMockImportUsecase creates a new mock instance.
+func NewMockImportUsecase(ctrl *gomock.Controller) *MockImportUsecase {
+	mock := &MockImportUsecase{ctrl: ctrl}
+	mock.recorder = &MockImportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportUsecase) EXPECT() *MockImportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockImportUsecase) Import(arg0 fs.FS, arg1 string, arg2 bool) (*dto.ImportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.ImportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockImportUsecaseMockRecorder) Import(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportUsecase)(nil).Import), arg0, arg1, arg2)
+}
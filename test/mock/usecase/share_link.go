@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/share_link.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShareLinkUsecase is a mock of ShareLinkUsecase interface.
+type MockShareLinkUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareLinkUsecaseMockRecorder
+}
+
+// MockShareLinkUsecaseMockRecorder is the mock recorder for MockShareLinkUsecase.
+type MockShareLinkUsecaseMockRecorder struct {
+	mock *MockShareLinkUsecase
+}
+
+// NewMockShareLinkUsecase creates a new mock instance.
+func NewMockShareLinkUsecase(ctrl *gomock.Controller) *MockShareLinkUsecase {
+	mock := &MockShareLinkUsecase{ctrl: ctrl}
+	mock.recorder = &MockShareLinkUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareLinkUsecase) EXPECT() *MockShareLinkUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockShareLinkUsecase) Create(arg0, arg1 *uint64, arg2 string, arg3 *time.Time, arg4 *uint64, arg5 entity.Principal) (*dto.ShareLinkDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*dto.ShareLinkDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockShareLinkUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShareLinkUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Download mocks base method.
+func (m *MockShareLinkUsecase) Download(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockShareLinkUsecaseMockRecorder) Download(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockShareLinkUsecase)(nil).Download), arg0)
+}
+
+// FindAll mocks base method.
+func (m *MockShareLinkUsecase) FindAll(arg0 entity.Principal) ([]dto.ShareLinkDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]dto.ShareLinkDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockShareLinkUsecaseMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockShareLinkUsecase)(nil).FindAll), arg0)
+}
+
+// Open mocks base method.
+func (m *MockShareLinkUsecase) Open(arg0, arg1 string) (*dto.ShareLinkDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0, arg1)
+	ret0, _ := ret[0].(*dto.ShareLinkDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockShareLinkUsecaseMockRecorder) Open(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockShareLinkUsecase)(nil).Open), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockShareLinkUsecase) Remove(arg0 uint64, arg1 entity.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockShareLinkUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockShareLinkUsecase)(nil).Remove), arg0, arg1)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/trash.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTrashUsecase is a mock of TrashUsecase interface.
+type MockTrashUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashUsecaseMockRecorder
+}
+
+// MockTrashUsecaseMockRecorder is the mock recorder for MockTrashUsecase.
+type MockTrashUsecaseMockRecorder struct {
+	mock *MockTrashUsecase
+}
+
+// NewMockTrashUsecase creates a new mock instance.
+func NewMockTrashUsecase(ctrl *gomock.Controller) *MockTrashUsecase {
+	mock := &MockTrashUsecase{ctrl: ctrl}
+	mock.recorder = &MockTrashUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashUsecase) EXPECT() *MockTrashUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockTrashUsecase) FindAll(arg0 entity.Principal) ([]dto.TrashItemDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]dto.TrashItemDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTrashUsecaseMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTrashUsecase)(nil).FindAll), arg0)
+}
+
+// Remove mocks base method.
+func (m *MockTrashUsecase) Remove(arg0 uint64, arg1 entity.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockTrashUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTrashUsecase)(nil).Remove), arg0, arg1)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockTrashUsecase) RemoveExpired(arg0 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockTrashUsecaseMockRecorder) RemoveExpired(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockTrashUsecase)(nil).RemoveExpired), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockTrashUsecase) Restore(arg0 uint64, arg1 string, arg2 entity.Principal) (*dto.TrashItemDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.TrashItemDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashUsecaseMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashUsecase)(nil).Restore), arg0, arg1, arg2)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/app/api/usecase/upload_session.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	entity "file-server/internal/app/api/domain/entity"
+	dto "file-server/internal/app/api/usecase/dto"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadSessionUsecase is a mock of UploadSessionUsecase interface.
+type MockUploadSessionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadSessionUsecaseMockRecorder
+}
+
+// MockUploadSessionUsecaseMockRecorder is the mock recorder for MockUploadSessionUsecase.
+type MockUploadSessionUsecaseMockRecorder struct {
+	mock *MockUploadSessionUsecase
+}
+
+// NewMockUploadSessionUsecase creates a new mock instance.
+func NewMockUploadSessionUsecase(ctrl *gomock.Controller) *MockUploadSessionUsecase {
+	mock := &MockUploadSessionUsecase{ctrl: ctrl}
+	mock.recorder = &MockUploadSessionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadSessionUsecase) EXPECT() *MockUploadSessionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockUploadSessionUsecase) Append(arg0 string, arg1 int64, arg2 io.Reader, arg3 entity.Principal) (*dto.UploadSessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dto.UploadSessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockUploadSessionUsecaseMockRecorder) Append(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockUploadSessionUsecase)(nil).Append), arg0, arg1, arg2, arg3)
+}
+
+// Complete mocks base method.
+func (m *MockUploadSessionUsecase) Complete(arg0 string, arg1 entity.Principal) (*dto.FileInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1)
+	ret0, _ := ret[0].(*dto.FileInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockUploadSessionUsecaseMockRecorder) Complete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockUploadSessionUsecase)(nil).Complete), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockUploadSessionUsecase) Create(arg0 uint64, arg1 string, arg2 int64, arg3 bool, arg4 entity.Principal) (*dto.UploadSessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.UploadSessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadSessionUsecaseMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadSessionUsecase)(nil).Create), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindOne mocks base method.
+func (m *MockUploadSessionUsecase) FindOne(arg0 string, arg1 entity.Principal) (*dto.UploadSessionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", arg0, arg1)
+	ret0, _ := ret[0].(*dto.UploadSessionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne.
+func (mr *MockUploadSessionUsecaseMockRecorder) FindOne(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockUploadSessionUsecase)(nil).FindOne), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockUploadSessionUsecase) Remove(arg0 string, arg1 entity.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUploadSessionUsecaseMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUploadSessionUsecase)(nil).Remove), arg0, arg1)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockUploadSessionUsecase) RemoveExpired(arg0 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockUploadSessionUsecaseMockRecorder) RemoveExpired(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockUploadSessionUsecase)(nil).RemoveExpired), arg0)
+}
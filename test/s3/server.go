@@ -0,0 +1,331 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type object struct {
+	body      []byte
+	etag      string
+	updatedAt time.Time
+}
+
+type Server struct {
+	server  *httptest.Server
+	mu      sync.Mutex
+	objects map[string]*object
+	uploads map[string]map[int]*object
+	nextID  int
+}
+
+func NewServer() *Server {
+	s := &Server{
+		objects: make(map[string]*object),
+		uploads: make(map[string]map[int]*object),
+	}
+	s.server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *Server) Client() (*minio.Client, error) {
+	u, err := url.Parse(s.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	return minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4("access", "secret", ""),
+		Secure:       true,
+		Transport:    s.server.Client().Transport,
+		Region:       "us-east-1",
+		BucketLookup: minio.BucketLookupPath,
+	})
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) Put(key string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = newObject(body)
+}
+
+func (s *Server) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[key]
+	if !ok {
+		return nil, false
+	}
+	return o.body, true
+}
+
+func (s *Server) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Server) Uploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.uploads)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(path) == 2 {
+		key = path[1]
+	}
+	query := r.URL.Query()
+
+	switch {
+	case key == "" && r.Method == http.MethodGet && query.Get("list-type") == "2":
+		s.list(w, query.Get("prefix"))
+	case key == "" && r.Method == http.MethodPost && query.Has("delete"):
+		s.removeAll(w, r)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextID++
+		id := strconv.Itoa(s.nextID)
+		s.uploads[id] = make(map[int]*object)
+		s.writeXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: path[0], Key: key, UploadId: id})
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		s.complete(w, r, path[0], key, query.Get("uploadId"))
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		s.uploadPart(w, r, query.Get("uploadId"), query.Get("partNumber"))
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		source, ok := s.source(w, r)
+		if !ok {
+			return
+		}
+		s.objects[key] = newObject(source)
+		s.writeXML(w, http.StatusOK, struct {
+			XMLName      xml.Name `xml:"CopyObjectResult"`
+			ETag         string
+			LastModified string
+		}{ETag: s.objects[key].etag, LastModified: s.objects[key].updatedAt.Format(time.RFC3339)})
+	case r.Method == http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		s.objects[key] = newObject(body)
+		w.Header().Set("ETag", s.objects[key].etag)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		o, ok := s.objects[key]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", o.etag)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, key, o.updatedAt, bytes.NewReader(o.body))
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int64
+	}
+	var contents []content
+	for k, v := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			contents = append(contents, content{Key: k, LastModified: v.updatedAt.Format(time.RFC3339), ETag: v.etag, Size: int64(len(v.body))})
+		}
+	}
+	sort.Slice(contents, func(i, j int) bool { return contents[i].Key < contents[j].Key })
+
+	s.writeXML(w, http.StatusOK, struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Prefix      string
+		KeyCount    int
+		IsTruncated bool
+		Contents    []content
+	}{Prefix: prefix, KeyCount: len(contents), Contents: contents})
+}
+
+func (s *Server) removeAll(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Object []struct {
+			Key string
+		}
+	}
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	for _, v := range request.Object {
+		delete(s.objects, v.Key)
+	}
+	s.writeXML(w, http.StatusOK, struct {
+		XMLName xml.Name `xml:"DeleteResult"`
+	}{})
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *http.Request, id string, partNumber string) {
+	parts, ok := s.uploads[id]
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	number, err := strconv.Atoi(partNumber)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+
+	if r.Header.Get("X-Amz-Copy-Source") != "" {
+		source, ok := s.source(w, r)
+		if !ok {
+			return
+		}
+		parts[number] = newObject(source)
+		s.writeXML(w, http.StatusOK, struct {
+			XMLName      xml.Name `xml:"CopyPartResult"`
+			ETag         string
+			LastModified string
+		}{ETag: parts[number].etag, LastModified: parts[number].updatedAt.Format(time.RFC3339)})
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError")
+		return
+	}
+	parts[number] = newObject(body)
+	w.Header().Set("ETag", parts[number].etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) complete(w http.ResponseWriter, r *http.Request, bucket string, key string, id string) {
+	parts, ok := s.uploads[id]
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	var request struct {
+		Part []struct {
+			PartNumber int
+		}
+	}
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	var body []byte
+	for _, v := range request.Part {
+		part, ok := parts[v.PartNumber]
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		body = append(body, part.body...)
+	}
+	delete(s.uploads, id)
+	s.objects[key] = newObject(body)
+
+	s.writeXML(w, http.StatusOK, struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Bucket  string
+		Key     string
+		ETag    string
+	}{Bucket: bucket, Key: key, ETag: s.objects[key].etag})
+}
+
+func (s *Server) source(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return nil, false
+	}
+	path := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	if len(path) != 2 {
+		s.writeError(w, http.StatusBadRequest, "InvalidArgument")
+		return nil, false
+	}
+	o, ok := s.objects[path[1]]
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "NoSuchKey")
+		return nil, false
+	}
+
+	body := o.body
+	if v := r.Header.Get("X-Amz-Copy-Source-Range"); v != "" {
+		var start, end int
+		if _, err := fmt.Sscanf(v, "bytes=%d-%d", &start, &end); err != nil || end < start || len(body) <= end {
+			s.writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return nil, false
+		}
+		body = body[start : end+1]
+	}
+	return body, true
+}
+
+func (s *Server) writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, code string) {
+	s.writeXML(w, status, struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: code})
+}
+
+func newObject(body []byte) *object {
+	sum := md5.Sum(body)
+	return &object{
+		body:      body,
+		etag:      "\"" + hex.EncodeToString(sum[:]) + "\"",
+		updatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}